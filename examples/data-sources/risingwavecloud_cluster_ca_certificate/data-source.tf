@@ -0,0 +1,19 @@
+data "risingwavecloud_cluster_ca_certificate" "mycluster" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+}
+
+resource "kubernetes_secret" "risingwave_ca" {
+  metadata {
+    name = "risingwave-ca"
+  }
+  data = {
+    "ca.crt" = data.risingwavecloud_cluster_ca_certificate.mycluster.pem
+  }
+}
+
+check "risingwave_ca_expiry" {
+  assert {
+    condition     = timecmp(data.risingwavecloud_cluster_ca_certificate.mycluster.not_after, timeadd(plantimestamp(), "720h")) > 0
+    error_message = "The CA certificate of the cluster expires within 30 days."
+  }
+}
@@ -0,0 +1,22 @@
+data "risingwavecloud_cluster_cloud_metadata" "mycluster" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+}
+
+resource "aws_vpc_security_group_ingress_rule" "kafka" {
+  for_each          = toset(data.risingwavecloud_cluster_cloud_metadata.mycluster.network.aws.nat_gateway_ips)
+  security_group_id = aws_security_group.kafka.id
+  cidr_ipv4         = "${each.value}/32"
+  ip_protocol       = "tcp"
+  from_port         = 9092
+  to_port           = 9092
+}
+
+resource "aws_vpc_endpoint_service_allowed_principal" "kafka" {
+  for_each                = toset(data.risingwavecloud_cluster_cloud_metadata.mycluster.network.aws.private_link_principals)
+  vpc_endpoint_service_id = aws_vpc_endpoint_service.kafka.id
+  principal_arn           = each.value
+}
+
+output "workload_role_arn" {
+  value = data.risingwavecloud_cluster_cloud_metadata.mycluster.iam.aws.workload_role_arn
+}
@@ -0,0 +1,17 @@
+data "risingwavecloud_cluster_endpoint" "mycluster" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  username   = risingwavecloud_cluster_user.app.username
+}
+
+provider "postgresql" {
+  host      = data.risingwavecloud_cluster_endpoint.mycluster.host
+  port      = data.risingwavecloud_cluster_endpoint.mycluster.port
+  database  = data.risingwavecloud_cluster_endpoint.mycluster.database
+  username  = risingwavecloud_cluster_user.app.username
+  password  = var.app_password
+  superuser = false
+}
+
+output "connection_uri" {
+  value = data.risingwavecloud_cluster_endpoint.mycluster.connection_uri
+}
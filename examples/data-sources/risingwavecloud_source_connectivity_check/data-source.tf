@@ -0,0 +1,28 @@
+data "risingwavecloud_source_connectivity_check" "orders" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  kafka = {
+    bootstrap_server  = "broker-1:9092"
+    security_protocol = "SASL_SSL"
+    sasl_mechanism    = "PLAIN"
+    sasl_username     = "rw"
+    sasl_password     = var.kafka_password
+    topic             = "orders"
+  }
+  list_objects = true
+}
+
+data "risingwavecloud_source_connectivity_check" "shop" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  postgres_cdc = {
+    hostname = "shop-db.internal"
+    port     = 5432
+    username = "rw"
+    password = var.shop_db_password
+    database = "shop"
+    ssl_mode = "required"
+  }
+}
+
+output "orders_topics" {
+  value = data.risingwavecloud_source_connectivity_check.orders.topics
+}
@@ -0,0 +1,16 @@
+data "risingwavecloud_cluster_endpoint" "mycluster" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+}
+
+ephemeral "risingwavecloud_cluster_oauth_token" "mycluster" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+}
+
+provider "postgresql" {
+  host      = data.risingwavecloud_cluster_endpoint.mycluster.host
+  port      = data.risingwavecloud_cluster_endpoint.mycluster.port
+  database  = data.risingwavecloud_cluster_endpoint.mycluster.database
+  username  = var.username
+  password  = ephemeral.risingwavecloud_cluster_oauth_token.mycluster.token
+  superuser = false
+}
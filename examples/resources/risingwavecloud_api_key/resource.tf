@@ -0,0 +1,14 @@
+resource "risingwavecloud_service_account" "ci" {
+  name    = "ci"
+  role_id = var.project_admin_role_id
+}
+
+resource "time_rotating" "monthly" {
+  rotation_months = 1
+}
+
+resource "risingwavecloud_api_key" "ci" {
+  service_account_id = risingwavecloud_service_account.ci.id
+  description        = "CI provider credentials"
+  rotation_trigger   = time_rotating.monthly.id
+}
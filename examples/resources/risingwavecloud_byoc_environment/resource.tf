@@ -0,0 +1,45 @@
+resource "risingwavecloud_byoc_environment" "prod" {
+  region   = "us-east-1"
+  name     = "prod"
+  settings = var.byoc_settings
+}
+
+resource "risingwavecloud_cluster" "mycluster" {
+  region  = risingwavecloud_byoc_environment.prod.region
+  name    = "mycluster"
+  tier    = "BYOC"
+  version = "v2.1.0"
+  byoc = {
+    env = risingwavecloud_byoc_environment.prod.name
+  }
+  spec = {
+    compute = {
+      default_node_group = {
+        cpu     = "2"
+        memory  = "8 GB"
+        replica = 1
+      }
+    }
+    compactor = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+    frontend = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+    meta = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+  }
+}
@@ -0,0 +1,53 @@
+resource "risingwavecloud_byok_environment" "prod" {
+  region = "us-east-1"
+  name   = "prod"
+  config = file("${path.module}/byok.yaml")
+}
+
+resource "risingwavecloud_cluster" "mycluster" {
+  region  = risingwavecloud_byok_environment.prod.region
+  name    = "mycluster"
+  version = "v2.1.0"
+  byok = {
+    env          = risingwavecloud_byok_environment.prod.name
+    iam_role_arn = "arn:aws:iam::123456789012:role/mycluster"
+    metastore = {
+      host                = "meta.example.internal"
+      port                = 5432
+      database            = "risingwave"
+      username            = "risingwave"
+      password_wo         = var.metastore_password
+      password_wo_version = 1
+    }
+  }
+  spec = {
+    compute = {
+      default_node_group = {
+        cpu     = "2"
+        memory  = "8 GB"
+        replica = 1
+      }
+    }
+    compactor = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+    frontend = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+    meta = {
+      default_node_group = {
+        cpu     = "1"
+        memory  = "4 GB"
+        replica = 1
+      }
+    }
+  }
+}
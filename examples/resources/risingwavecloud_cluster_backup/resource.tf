@@ -0,0 +1,5 @@
+resource "risingwavecloud_cluster_backup" "pre_upgrade" {
+  # Take the snapshot before the cluster is upgraded: the cluster depends on this resource, so
+  # the cluster ID is passed in as a variable instead of referencing the cluster.
+  cluster_id = var.cluster_id
+}
@@ -0,0 +1,10 @@
+resource "risingwavecloud_cluster_iceberg_compaction" "lake" {
+  # Reference the cluster instead of hardcoding its ID, so that Terraform creates the
+  # cluster first and disables the extension before deleting the cluster.
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  compactor = {
+    cpu     = "2"
+    memory  = "8 GB"
+    replica = 1
+  }
+}
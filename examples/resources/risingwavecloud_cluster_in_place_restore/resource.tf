@@ -0,0 +1,5 @@
+resource "risingwavecloud_cluster_in_place_restore" "rollback" {
+  cluster_id        = risingwavecloud_cluster_backup.pre_migration.cluster_id
+  snapshot_id       = risingwavecloud_cluster_backup.pre_migration.snapshot_id
+  confirm_data_loss = true
+}
@@ -0,0 +1,7 @@
+resource "risingwavecloud_cluster_serverless_backfill" "backfill" {
+  # Reference the cluster instead of hardcoding its ID, so that Terraform creates the
+  # cluster first and disables the extension before deleting the cluster.
+  cluster_id        = risingwavecloud_cluster.mycluster.id
+  component_type_id = "p-2c8g"
+  replica           = 2
+}
@@ -0,0 +1,7 @@
+resource "risingwavecloud_database" "analytics" {
+  # Reference the cluster and the resource group instead of hardcoding them, so that
+  # Terraform creates them first and drops the database before deleting them.
+  cluster_id     = risingwavecloud_cluster.mycluster.id
+  name           = "analytics"
+  resource_group = risingwavecloud_cluster_resource_group.analytics.name
+}
@@ -0,0 +1,14 @@
+resource "risingwavecloud_materialized_view" "revenue" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  database   = "dev"
+  schema     = "public"
+  name       = "revenue_per_minute"
+  definition = <<-SQL
+    SELECT window_start, SUM(amount) AS revenue
+    FROM TUMBLE(orders, created_at, INTERVAL '1 MINUTE')
+    GROUP BY window_start
+  SQL
+
+  # The apply waits until the materialized view has backfilled the existing data.
+  backfill_timeout = "2h"
+}
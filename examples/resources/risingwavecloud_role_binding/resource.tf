@@ -0,0 +1,6 @@
+resource "risingwavecloud_role_binding" "pipeline" {
+  # The other roles of the service account are kept.
+  principal      = risingwavecloud_service_account.pipeline.id
+  principal_type = "ServiceAccount"
+  role_id        = var.project_viewer_role_id
+}
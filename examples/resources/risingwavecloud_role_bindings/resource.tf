@@ -0,0 +1,16 @@
+resource "risingwavecloud_role_bindings" "org" {
+  # This resource owns every binding of the organization: a role bound in the console is
+  # unbound on the next apply. Keep the binding of the service account Terraform runs as.
+  bindings = [
+    {
+      principal      = var.terraform_service_account_id
+      principal_type = "ServiceAccount"
+      role_id        = var.organization_admin_role_id
+    },
+    {
+      principal      = risingwavecloud_service_account.pipeline.id
+      principal_type = "ServiceAccount"
+      role_id        = var.project_admin_role_id
+    },
+  ]
+}
@@ -0,0 +1,15 @@
+variable "kafka_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "risingwavecloud_secret" "kafka_password" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  database   = "dev"
+  name       = "kafka_password"
+
+  # Requires Terraform 1.11 or later. Increment the version whenever the value changes.
+  value_wo         = var.kafka_password
+  value_wo_version = 1
+}
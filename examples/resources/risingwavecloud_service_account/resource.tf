@@ -0,0 +1,5 @@
+resource "risingwavecloud_service_account" "pipeline" {
+  name        = "pipeline-orders"
+  description = "Deploys the orders pipeline."
+  role_id     = var.project_admin_role_id
+}
@@ -0,0 +1,18 @@
+resource "risingwavecloud_sink" "revenue" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  database   = "dev"
+  schema     = "public"
+  name       = "revenue_to_kafka"
+  from       = risingwavecloud_materialized_view.revenue.name
+  connector  = "kafka"
+  properties = {
+    "topic"                       = "revenue"
+    "properties.bootstrap.server" = "broker-1:9092"
+  }
+
+  format = "PLAIN"
+  encode = "JSON"
+  encode_properties = {
+    "force_append_only" = "true"
+  }
+}
@@ -0,0 +1,23 @@
+resource "risingwavecloud_source" "orders" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  database   = "dev"
+  schema     = "public"
+  name       = "orders"
+  columns    = "id BIGINT, amount DECIMAL, created_at TIMESTAMPTZ"
+  connector  = "kafka"
+  properties = {
+    "topic"                        = "orders"
+    "properties.bootstrap.server"  = "broker-1:9092"
+    "properties.sasl.username"     = "rw"
+    "properties.sasl.mechanism"    = "PLAIN"
+    "properties.security.protocol" = "SASL_SSL"
+  }
+
+  # The password is written as `properties.sasl.password = SECRET kafka_password`.
+  secret_properties = {
+    "properties.sasl.password" = risingwavecloud_secret.kafka_password.name
+  }
+
+  format = "PLAIN"
+  encode = "JSON"
+}
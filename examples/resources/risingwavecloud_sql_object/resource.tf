@@ -0,0 +1,15 @@
+resource "risingwavecloud_sql_object" "orders" {
+  cluster_id = risingwavecloud_cluster.mycluster.id
+  database   = "dev"
+
+  # One statement per element, run in order.
+  create_sql = [
+    "CREATE TABLE orders (id BIGINT PRIMARY KEY, amount DECIMAL)",
+  ]
+  destroy_sql = [
+    "DROP TABLE IF EXISTS orders",
+  ]
+
+  # Returns a row while the table exists, so that Terraform notices if it is dropped.
+  read_sql = "SELECT 1 FROM rw_catalog.rw_tables WHERE name = 'orders'"
+}
@@ -5,20 +5,25 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen"
 	apigen_acc "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v1"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
 	apigen_mgmtv1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
 )
 
 var (
-	ErrInvalidCredential = errors.New("invalid credential")
+	ErrInvalidCredential      = errors.New("invalid credential")
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrAPIKeyNotFound         = errors.New("API key not found")
 )
 
 const (
@@ -43,6 +48,18 @@ type CloudClientInterface interface {
 
 	CreateClusterAwait(ctx context.Context, region string, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error)
 
+	// CreateClusterAwaitingConfig creates a BYOK cluster and waits for it to await its BYOK
+	// configuration, which the platform needs before deploying it.
+	CreateClusterAwaitingConfig(ctx context.Context, region string, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error)
+
+	// UpdateClusterBYOKConfig sets the BYOK configuration of the cluster without waiting for the
+	// cluster to pick it up.
+	UpdateClusterBYOKConfig(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) (*apigen_mgmtv2.Tenant, error)
+
+	// UpdateClusterBYOKConfigAwait sets the BYOK configuration of a deployed cluster and waits for
+	// it to be running and healthy again.
+	UpdateClusterBYOKConfigAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) error
+
 	GetTiers(ctx context.Context, region string) ([]apigen_mgmtv1.Tier, error)
 
 	GetAvailableComponentTypes(ctx context.Context, region string, targetTier apigen_mgmtv1.TierId, component string) ([]apigen_mgmtv1.AvailableComponentType, error)
@@ -55,6 +72,118 @@ type CloudClientInterface interface {
 
 	UpdateRisingWaveConfigByNsIDAwait(ctx context.Context, nsID uuid.UUID, rwConfig string) error
 
+	// RestoreCluster starts restoring a backup snapshot of the cluster into a new cluster in the
+	// same region. It returns the NsID of the new cluster as soon as the platform lists it, without
+	// waiting for it to be running.
+	RestoreCluster(ctx context.Context, nsID, snapshotID uuid.UUID, newClusterName string) (uuid.UUID, error)
+
+	// WaitClusterRunning waits for the cluster to be running and healthy, e.g. a restored one.
+	WaitClusterRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Tenant, error)
+
+	// InPlaceRestoreClusterAwait restores a backup snapshot into the cluster it was taken of,
+	// replacing all of its data, and waits for the cluster to be running and healthy again. it
+	// returns ErrClusterNotRunning without touching the cluster unless it is running.
+	InPlaceRestoreClusterAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error
+
+	// GetClusterMaintenanceWindow returns the time window in which the platform may run
+	// maintenance on the cluster, e.g. automatic upgrades.
+	GetClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.MaintenanceWindow, error)
+
+	// UpdateClusterMaintenanceWindow replaces the maintenance window of the cluster.
+	UpdateClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID, window apigen_mgmtv2.MaintenanceWindow) error
+
+	// StopClusterAwait stops the cluster and waits for it to be stopped.
+	StopClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	// StartClusterAwait starts a stopped cluster and waits for it to be running and healthy.
+	StartClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	// RestartClusterAwait restarts the nodes of the cluster and waits for it to be healthy again.
+	RestartClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	/* Extensions */
+
+	// GetServerlessCompaction returns the parameters of the serverless compaction extension. Whether
+	// it is enabled is part of the cluster info.
+	GetServerlessCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, error)
+
+	// EnableServerlessCompactionAwait enables the serverless compaction extension and waits for the
+	// cluster to be running and healthy again.
+	EnableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error
+
+	// UpdateServerlessCompactionAwait updates the serverless compaction extension and waits for the
+	// cluster to be running and healthy again.
+	UpdateServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error
+
+	// DisableServerlessCompactionAwait disables the serverless compaction extension and waits for
+	// the cluster to be running and healthy again.
+	DisableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID) error
+
+	// GetIcebergCompaction returns the iceberg compaction extension of the cluster, or
+	// ErrIcebergCompactionNotFound if it is not enabled.
+	GetIcebergCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	// EnableIcebergCompactionAwait enables the iceberg compaction extension once the cluster is
+	// idle and waits for it to be running. accepted is called as soon as the platform accepts the
+	// request, before the wait, e.g. to record the extension in the state.
+	EnableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody, accepted func()) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	// UpdateIcebergCompactionAwait updates the iceberg compaction extension and waits for it to be
+	// running again.
+	UpdateIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	// DisableIcebergCompactionAwait disables the iceberg compaction extension and waits for it to
+	// be gone. it returns nil if the extension is disabled successfully or not enabled.
+	DisableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID) error
+
+	// GetServerlessBackfill returns the serverless backfill extension of the cluster, or
+	// ErrServerlessBackfillNotFound if it is not enabled.
+	GetServerlessBackfill(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error)
+
+	// EnableServerlessBackfillAwait enables the serverless backfill extension and waits for the
+	// cluster to be running and healthy again. accepted is called as soon as the platform accepts
+	// the request, before the wait, e.g. to record the extension in the state.
+	EnableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest, accepted func()) error
+
+	// UpdateServerlessBackfillAwait resizes or upgrades the serverless backfill extension and waits
+	// for the cluster to be running and healthy again.
+	UpdateServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error
+
+	// DisableServerlessBackfillAwait disables the serverless backfill extension and waits for the
+	// cluster to be running and healthy again.
+	DisableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID) error
+
+	// GetComputeCache returns the current and the desired compute cache of the cluster.
+	GetComputeCache(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheResponseBody, error)
+
+	// GetComputeCacheCapabilities returns the sizes and performance tiers the compute cache of the
+	// cluster can be set to.
+	GetComputeCacheCapabilities(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, error)
+
+	// UpdateComputeCacheAwait updates the compute cache of the cluster and waits for the cluster to
+	// be rescaled.
+	UpdateComputeCacheAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantComputeCacheRequestBody) error
+
+	// GetClusterCloudMetadata returns the metadata of the cloud resources of the cluster, e.g. the
+	// egress IPs and the workload identity. The network and IAM unions are in the variant of the
+	// cloud provider of the cluster.
+	GetClusterCloudMetadata(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.TenantCloudMetadata, error)
+
+	// GetClusterEndpoint returns the endpoint to connect to the cluster with a Postgres client. A
+	// cluster that is not deployed yet has no endpoint, in which case ErrClusterNotFound is returned.
+	GetClusterEndpoint(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Endpoint, error)
+
+	// GetClusterCACertificate returns the PEM encoded certificate chain clients verify the endpoint
+	// of the cluster with.
+	GetClusterCACertificate(ctx context.Context, nsID uuid.UUID) (string, error)
+
+	// GetRootCACertificate returns the PEM encoded root CA certificate of the region.
+	GetRootCACertificate(ctx context.Context, region string) (string, error)
+
+	// CreateClusterOAuthToken issues a short-lived OAuth token to connect to the cluster with. The
+	// token is a secret and is never stored.
+	CreateClusterOAuthToken(ctx context.Context, nsID uuid.UUID) (string, error)
+
 	/* Cluster User */
 
 	GetClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) (*apigen_mgmtv2.DBUser, error)
@@ -65,6 +194,94 @@ type CloudClientInterface interface {
 
 	DeleteClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) error
 
+	/* Database */
+
+	// GetDatabase returns the database of the given name in the cluster, or ErrDatabaseNotFound.
+	GetDatabase(ctx context.Context, clusterNsID uuid.UUID, name string) (*apigen_mgmtv2.Database, error)
+
+	// CreateDatabase creates a database whose streaming jobs run in the given resource group.
+	CreateDatabase(ctx context.Context, clusterNsID uuid.UUID, name, resourceGroup string) (*apigen_mgmtv2.Database, error)
+
+	// DeleteDatabase drops the database. it returns nil if the database does not exist.
+	DeleteDatabase(ctx context.Context, clusterNsID uuid.UUID, name string) error
+
+	/* Secret */
+
+	// GetSecret returns the secret of the given name in the database, or ErrSecretNotFound.
+	GetSecret(ctx context.Context, clusterNsID uuid.UUID, database, name string) (*apigen_mgmtv2.Secret, error)
+
+	CreateSecret(ctx context.Context, clusterNsID uuid.UUID, database, name, value string) error
+
+	// DeleteSecret drops the secret. it returns nil if the secret does not exist.
+	DeleteSecret(ctx context.Context, clusterNsID uuid.UUID, database, name string) error
+
+	// GetSecretReferences returns the objects using the secret, e.g. sources and sinks.
+	GetSecretReferences(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	/* SQL */
+
+	// ExecuteSQLBatch runs the statements in order. A statement that fails is reported in the
+	// Error of its result rather than as an error, which is only returned if the batch could not
+	// be run at all.
+	ExecuteSQLBatch(ctx context.Context, clusterNsID uuid.UUID, database string, queries []string) ([]apigen_mgmtv2.BatchQueryResult, error)
+
+	// QuerySQL runs a single query and returns its rows.
+	QuerySQL(ctx context.Context, clusterNsID uuid.UUID, database, query string) (*apigen_mgmtv2.QueryResult, error)
+
+	/* Materialized View */
+
+	// GetMatView returns the materialized view of the given name in the schema, or ErrMatViewNotFound.
+	GetMatView(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.MatView, error)
+
+	// WaitMatViewBackfilled waits until the materialized view has backfilled the existing data of
+	// its upstreams, or the timeout expires.
+	WaitMatViewBackfilled(ctx context.Context, clusterNsID uuid.UUID, database, name string, timeout time.Duration) error
+
+	// GetMatViewDownstreams returns the objects reading from the materialized view.
+	GetMatViewDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	// DeleteMatView drops the materialized view. it returns nil if the materialized view does not exist.
+	DeleteMatView(ctx context.Context, clusterNsID uuid.UUID, database, name string) error
+
+	/* Source and Sink */
+
+	// GetSource returns the source of the given name in the schema, or ErrSourceNotFound.
+	GetSource(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SourceInfo, error)
+
+	// GetSourceDownstreams returns the objects reading from the source.
+	GetSourceDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	// DeleteSource drops the source. it returns nil if the source does not exist.
+	DeleteSource(ctx context.Context, clusterNsID uuid.UUID, database, name string) error
+
+	// GetSink returns the sink of the given name in the schema, or ErrSinkNotFound.
+	GetSink(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SinkInfo, error)
+
+	// GetSinkDownstreams returns the objects depending on the sink.
+	GetSinkDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	// DeleteSink drops the sink. it returns nil if the sink does not exist.
+	DeleteSink(ctx context.Context, clusterNsID uuid.UUID, database, name string) error
+
+	/* Source Connectivity */
+
+	// PingSource asks the cluster to connect to the upstream system of a source. It returns
+	// ErrSourceUnreachable with the message of the platform if the upstream cannot be reached.
+	PingSource(ctx context.Context, clusterNsID uuid.UUID, req apigen_mgmtv2.PostSourcesPingRequestBody) error
+
+	FetchKafkaTopics(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.KafkaConfig) ([]string, error)
+
+	// FetchKafkaSchema returns the columns inferred from the messages of the topic.
+	FetchKafkaSchema(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.KafkaConfig, topic string) ([]apigen_mgmtv2.ColumnDesc, error)
+
+	FetchPostgresTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.PostgresCdcConfig) ([]string, error)
+
+	FetchMysqlTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.MysqlCdcConfig) ([]string, error)
+
+	FetchSqlserverTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.SqlserverCdcConfig) ([]string, error)
+
+	FetchMongodbCollections(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.MongodbCdcConfig) ([]string, error)
+
 	/* Private Link */
 
 	GetPrivateLinks(ctx context.Context) ([]PrivateLinkInfo, error)
@@ -84,6 +301,43 @@ type CloudClientInterface interface {
 
 	GetBYOCCluster(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error)
 
+	// CreateBYOCCluster creates a BYOC cluster, i.e. a BYOC environment, without waiting for it
+	// to be provisioned.
+	CreateBYOCCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByocClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error)
+
+	// WaitBYOCClusterReady waits for the BYOC cluster to be ready. It gives up as soon as the
+	// cluster fails.
+	WaitBYOCClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	// UpdateBYOCCluster replaces the settings of the BYOC cluster with the ones in the request.
+	UpdateBYOCCluster(ctx context.Context, region string, name string, req apigen_mgmtv2.PutByocClusterRequestBody) error
+
+	// UpdateBYOCClusterVersionAwait upgrades the BYOC cluster to the version and waits for it to
+	// be ready again.
+	UpdateBYOCClusterVersionAwait(ctx context.Context, region string, name, version string) error
+
+	// DeleteBYOCClusterAwait terminates the BYOC cluster, waits for it to be terminated and then
+	// deletes it. It returns nil if the BYOC cluster does not exist.
+	DeleteBYOCClusterAwait(ctx context.Context, region string, name string) error
+
+	GetBYOKCluster(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	// CreateBYOKCluster creates a BYOK cluster, i.e. a BYOK environment, without waiting for it
+	// to be provisioned.
+	CreateBYOKCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByokClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error)
+
+	// WaitBYOKClusterReady waits for the BYOK cluster to be ready. It gives up as soon as the
+	// cluster fails.
+	WaitBYOKClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	// UpdateBYOKClusterVersionAwait upgrades the BYOK cluster to the version and waits for it to
+	// be ready again.
+	UpdateBYOKClusterVersionAwait(ctx context.Context, region string, name, version string) error
+
+	// DeleteBYOKClusterAwait terminates the BYOK cluster, waits for it to be terminated and then
+	// deletes it. It returns nil if the BYOK cluster does not exist.
+	DeleteBYOKClusterAwait(ctx context.Context, region string, name string) error
+
 	/* Resource Group */
 
 	// GetResourceGroup returns the resource group of the given name in the cluster.
@@ -112,16 +366,86 @@ type CloudClientInterface interface {
 	// RemoveAllowedIamRoleAwait disallows an IAM role and waits for the change to be applied.
 	// it returns nil if the role is not allowed in the first place.
 	RemoveAllowedIamRoleAwait(ctx context.Context, clusterNsID uuid.UUID, roleArn string) error
+
+	/* Backup */
+
+	// GetBackupSnapshot returns the backup snapshot of the given ID in the cluster.
+	GetBackupSnapshot(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error)
+
+	// CreateBackupSnapshot starts taking a snapshot of the cluster and returns its ID without
+	// waiting for it to complete.
+	CreateBackupSnapshot(ctx context.Context, clusterNsID uuid.UUID) (uuid.UUID, error)
+
+	// WaitBackupSnapshotCompleted waits for the snapshot to complete. it returns an error as soon
+	// as the platform reports the snapshot as failed.
+	WaitBackupSnapshotCompleted(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error)
+
+	// DeleteBackupSnapshotAwait deletes the snapshot and waits for the deletion to complete. it
+	// returns nil if the snapshot is deleted successfully or not found.
+	DeleteBackupSnapshotAwait(ctx context.Context, clusterNsID, snapshotID uuid.UUID) error
+
+	/* Service Account */
+
+	// GetServiceAccount returns the service account of the given ID, or ErrServiceAccountNotFound.
+	GetServiceAccount(ctx context.Context, id uuid.UUID) (*apigen_accv2.ServiceAccount, error)
+
+	// CreateServiceAccount creates a service account in the organization of the API key, bound to
+	// the given role.
+	CreateServiceAccount(ctx context.Context, name, description string, roleID uuid.UUID) (*apigen_accv2.ServiceAccount, error)
+
+	UpdateServiceAccountDescription(ctx context.Context, id uuid.UUID, description string) error
+
+	// DeleteServiceAccount deletes the service account. it returns nil if the service account does
+	// not exist.
+	DeleteServiceAccount(ctx context.Context, id uuid.UUID) error
+
+	/* API Key */
+
+	// GetAPIKey returns the API key of the given ID, or ErrAPIKeyNotFound. The secret of the key is
+	// only returned on creation.
+	GetAPIKey(ctx context.Context, id uint64) (*apigen_accv2.ApiKey, error)
+
+	// CreateAPIKey creates an API key owned by the principal, e.g. a service account, and returns
+	// it with its secret.
+	CreateAPIKey(ctx context.Context, principal uuid.UUID, description string) (*apigen_accv2.CreatedApiKey, error)
+
+	UpdateAPIKeyDescription(ctx context.Context, id uint64, description string) (*apigen_accv2.ApiKey, error)
+
+	// DeleteAPIKey deletes the API key. it returns nil if the API key does not exist.
+	DeleteAPIKey(ctx context.Context, id uint64) error
+
+	/* Role Binding */
+
+	// GetRoles returns the roles that can be bound in the organization.
+	GetRoles(ctx context.Context) ([]apigen_accv2.Role, error)
+
+	// GetRoleBindings returns the role bindings of the organization, or only those of the
+	// principal if it is not nil.
+	GetRoleBindings(ctx context.Context, principal *uuid.UUID) ([]apigen_accv2.RoleBinding, error)
+
+	// UpdateRoleBindings replaces the roles bound to the principal, an empty list unbinds all of them.
+	UpdateRoleBindings(ctx context.Context, principal uuid.UUID, principalType string, roleIDs []uuid.UUID) error
+
+	// AddRoleBinding binds the role to the principal, keeping the other roles of the principal.
+	AddRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error
+
+	// RemoveRoleBinding unbinds the role from the principal, keeping the other roles of the principal.
+	// it returns nil if the role is not bound to the principal.
+	RemoveRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error
 }
 
 type CloudClient struct {
-	Endpoint   string
-	accClient  *apigen_acc.ClientWithResponses
-	apiKeyPair string
-	regions    map[string]RegionServiceClientInterface
+	Endpoint    string
+	accClient   *apigen_acc.ClientWithResponses
+	accV2Client *apigen_accv2.ClientWithResponses
+	apiKeyPair  string
+	regions     map[string]RegionServiceClientInterface
 
 	// rescaleLocks holds one mutex per cluster NsID (uuid.UUID -> *sync.Mutex).
 	rescaleLocks sync.Map
+
+	// roleBindingLocks holds one mutex per principal (uuid.UUID -> *sync.Mutex).
+	roleBindingLocks sync.Map
 }
 
 // lockClusterRescale serializes the operations that rescale a cluster. Rescaling is
@@ -140,6 +464,20 @@ func (c *CloudClient) lockClusterRescale(nsID uuid.UUID) func() {
 	return mu.Unlock
 }
 
+// lockRoleBindings serializes the updates of the role bindings of a principal. The API replaces
+// all roles of a principal at once, so binding one role is a read-modify-write, and terraform
+// applies the bindings of the same principal concurrently. The returned function releases the lock.
+func (c *CloudClient) lockRoleBindings(principal uuid.UUID) func() {
+	v, _ := c.roleBindingLocks.LoadOrStore(principal, &sync.Mutex{})
+	mu, ok := v.(*sync.Mutex)
+	if !ok {
+		// unreachable: nothing else is ever stored in this map.
+		return func() {}
+	}
+	mu.Lock()
+	return mu.Unlock
+}
+
 func NewCloudClient(ctx context.Context, endpoint, apiKey, apiSecret, tfPluginVersion string) (CloudClientInterface, error) {
 	apiKeyPair := fmt.Sprintf("%s:%s", apiKey, apiSecret)
 
@@ -155,6 +493,11 @@ func NewCloudClient(ctx context.Context, endpoint, apiKey, apiSecret, tfPluginVe
 		return nil, err
 	}
 
+	accV2Client, err := apigen_accv2.NewClientWithResponses(accV2Endpoint(endpoint), apigen_accv2.WithRequestEditorFn(requestEditor))
+	if err != nil {
+		return nil, err
+	}
+
 	// get regions
 	res, err := accClient.GetRegionsWithResponse(ctx)
 	if err != nil {
@@ -181,13 +524,21 @@ func NewCloudClient(ctx context.Context, endpoint, apiKey, apiSecret, tfPluginVe
 	}
 
 	return &CloudClient{
-		Endpoint:   endpoint,
-		accClient:  accClient,
-		regions:    regionMap,
-		apiKeyPair: apiKeyPair,
+		Endpoint:    endpoint,
+		accClient:   accClient,
+		accV2Client: accV2Client,
+		regions:     regionMap,
+		apiKeyPair:  apiKeyPair,
 	}, nil
 }
 
+// accV2Endpoint returns the endpoint of the v2 account API, which is served next to the v1 one,
+// e.g. https://canary-useast2-acc.risingwave.cloud/api/v2 for .../api/v1.
+func accV2Endpoint(endpoint string) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return strings.TrimSuffix(endpoint, "/v1") + "/v2"
+}
+
 func createRegionServiceClient(urlV1, urlV2 string, reqEditor func(ctx context.Context, req *http.Request) error) (RegionServiceClientInterface, error) {
 	mgmtV1Client, err := apigen_mgmtv1.NewClientWithResponses(urlV1, apigen_mgmtv1.WithRequestEditorFn(reqEditor))
 	if err != nil {
@@ -268,6 +619,15 @@ func (c *CloudClient) CreateClusterAwait(ctx context.Context, region string, req
 	return rs.CreateClusterAwait(ctx, req)
 }
 
+func (c *CloudClient) CreateClusterAwaitingConfig(ctx context.Context, region string, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.CreateClusterAwaitingConfig(ctx, req)
+}
+
 func (c *CloudClient) GetTiers(ctx context.Context, region string) ([]apigen_mgmtv1.Tier, error) {
 	rs, err := c.getRegionClient(region)
 	if err != nil {
@@ -324,156 +684,733 @@ func (c *CloudClient) UpdateRisingWaveConfigByNsIDAwait(ctx context.Context, nsI
 	return rs.UpdateRisingWaveConfigAwait(ctx, info.NsId, rwConfig)
 }
 
-func (c *CloudClient) GetClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) (*apigen_mgmtv2.DBUser, error) {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
-	if err != nil {
-		return nil, err
-	}
-	users, err := rs.GetClusterUsers(ctx, info.NsId)
+func (c *CloudClient) RestoreCluster(ctx context.Context, nsID, snapshotID uuid.UUID, newClusterName string) (uuid.UUID, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
-		if errors.Is(err, ErrClusterNotFound) {
-			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s", clusterNsID.String())
-		}
-		return nil, err
-	}
-	for _, user := range users {
-		if user.Username == username {
-			return ptr.Ptr(user), nil
-		}
+		return uuid.Nil, err
 	}
-	return nil, errors.Errorf("user %s not found in cluster %s", username, clusterNsID.String())
+
+	return rs.RestoreCluster(ctx, info.NsId, snapshotID, newClusterName)
 }
 
-func (c *CloudClient) CreateClusterUser(ctx context.Context, clusterNsID uuid.UUID, username, password string, createDB, superUser, createUser bool) (*apigen_mgmtv2.DBUser, error) {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+func (c *CloudClient) WaitClusterRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Tenant, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
 		return nil, err
 	}
-	u, err := rs.CreateClusterUser(ctx, info.NsId, apigen_mgmtv2.CreateDBUserRequestBody{
-		Username:   username,
-		Password:   password,
-		Createdb:   createDB,
-		Superuser:  superUser,
-		Createuser: ptr.Ptr(createUser),
-	})
+
+	return rs.WaitClusterRunning(ctx, info.NsId)
+}
+
+func (c *CloudClient) UpdateClusterBYOKConfig(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
-		if errors.Is(err, ErrClusterNotFound) {
-			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s", clusterNsID.String())
-		}
 		return nil, err
 	}
-	return u, err
+
+	return rs.UpdateClusterBYOKConfig(ctx, info.NsId, req)
 }
 
-func (c *CloudClient) UpdateClusterUserPassword(ctx context.Context, clusterNsID uuid.UUID, username, password string) error {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+func (c *CloudClient) UpdateClusterBYOKConfigAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) error {
+	// the new configuration redeploys the cluster, it must not overlap a rescale.
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
 		return err
 	}
-	return rs.UpdateClusterUserPassword(ctx, info.NsId, username, password)
+
+	return rs.UpdateClusterBYOKConfigAwait(ctx, info.NsId, req)
 }
 
-func (c *CloudClient) DeleteClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) error {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+func (c *CloudClient) InPlaceRestoreClusterAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error {
+	// the restore restarts every component of the cluster, it must not overlap a rescale.
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
 		return err
 	}
-	return rs.DeleteClusterUser(ctx, info.NsId, username)
-}
-
-type PrivateLinkInfo struct {
-	ClusterNsID uuid.UUID
-	PrivateLink *apigen_mgmtv2.PrivateLink
-}
 
-func (c *CloudClient) GetPrivateLinks(ctx context.Context) ([]PrivateLinkInfo, error) {
-	var (
-		offset uint64 = 0
-		limit  uint64 = 10
-	)
-	var privateLinks []apigen_acc.PrivateLink
-	for {
-		res, err := c.accClient.GetPrivatelinksWithResponse(ctx, &apigen_acc.GetPrivatelinksParams{
-			Offset: &offset,
-			Limit:  &limit,
-		})
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to call API get private links")
-		}
-		if err = apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
-			return nil, err
-		}
-		offset = res.JSON200.Offset
-		limit = res.JSON200.Limit
-		privateLinks = append(privateLinks, res.JSON200.PrivateLinks...)
-		if limit*(offset+1) >= res.JSON200.Size {
-			break
-		}
-		offset++
-	}
-	var plInfos []PrivateLinkInfo
-	for _, accpl := range privateLinks {
-		rs, err := c.getRegionClient(accpl.Region)
-		if err != nil {
-			return nil, err
-		}
-		pl, err := rs.GetPrivateLink(ctx, accpl.TenantNsId, accpl.Id)
-		if err != nil {
-			return nil, err
-		}
-		cluster, err := rs.GetClusterByNsID(ctx, accpl.TenantNsId)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get cluster through id %d provided by private link with ID %s", accpl.TenantId, pl.Id.String())
-		}
-		plInfos = append(plInfos, PrivateLinkInfo{
-			ClusterNsID: cluster.NsId,
-			PrivateLink: pl,
-		})
-	}
-	return plInfos, nil
+	return rs.InPlaceRestoreClusterAwait(ctx, info.NsId, snapshotID)
 }
 
-func (c *CloudClient) GetPrivateLink(ctx context.Context, privateLinkID uuid.UUID) (*PrivateLinkInfo, error) {
-	pls, err := c.GetPrivateLinks(ctx)
+func (c *CloudClient) GetClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.MaintenanceWindow, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
 		return nil, err
 	}
-	for _, pl := range pls {
-		if pl.PrivateLink.Id == privateLinkID {
-			return &pl, nil
-		}
-	}
 
-	return nil, errors.Wrapf(ErrPrivateLinkNotFound, "private link %s", privateLinkID.String())
+	return rs.GetMaintenanceWindow(ctx, info.NsId)
 }
 
-func (c *CloudClient) CreatePrivateLinkAwait(ctx context.Context, clusterNsID uuid.UUID, req apigen_mgmtv2.PostPrivateLinkRequestBody) (*PrivateLinkInfo, error) {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
-	if err != nil {
-		return nil, err
-	}
-	pl, err := rs.CreatePrivateLinkAwait(ctx, info.NsId, req)
+func (c *CloudClient) UpdateClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID, window apigen_mgmtv2.MaintenanceWindow) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &PrivateLinkInfo{
-		ClusterNsID: info.NsId,
-		PrivateLink: pl,
-	}, nil
+
+	return rs.UpdateMaintenanceWindow(ctx, info.NsId, window)
 }
 
-func (c *CloudClient) DeletePrivateLinkAwait(ctx context.Context, clusterNsID uuid.UUID, privateLinkID uuid.UUID) error {
-	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+func (c *CloudClient) StopClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
 		return err
 	}
-	return rs.DeletePrivateLinkAwait(ctx, info.NsId, privateLinkID)
+
+	return rs.StopClusterAwait(ctx, info.NsId)
 }
 
-func (c *CloudClient) GetClusterByRegionAndName(ctx context.Context, region string, name string) (*apigen_mgmtv2.Tenant, error) {
-	rs, err := c.getRegionClient(region)
+func (c *CloudClient) StartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	return rs.StartClusterAwait(ctx, info.NsId)
+}
+
+func (c *CloudClient) RestartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.RestartClusterAwait(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetServerlessCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetServerlessCompaction(ctx, info.NsId)
+}
+
+// the extension operations take the cluster out of the running status like a rescale does, so
+// they are serialized with the rescales of the same cluster.
+
+func (c *CloudClient) EnableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.EnableServerlessCompactionAwait(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) UpdateServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.UpdateServerlessCompactionAwait(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) DisableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.DisableServerlessCompactionAwait(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetIcebergCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetIcebergCompaction(ctx, info.NsId)
+}
+
+// EnableIcebergCompactionAwait holds the rescale lock from the request until the extension is
+// running: the iceberg compactor is deployed next to the cluster's own nodes, so enabling, resizing
+// or disabling it is serialized with the rescales of the same cluster.
+func (c *CloudClient) EnableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody, accepted func()) (*apigen_mgmtv2.IcebergCompaction, error) {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rs.EnableIcebergCompaction(ctx, info.NsId, req); err != nil {
+		return nil, err
+	}
+	accepted()
+
+	return rs.WaitIcebergCompactionRunning(ctx, info.NsId)
+}
+
+func (c *CloudClient) UpdateIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen_mgmtv2.IcebergCompaction, error) {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.UpdateIcebergCompactionAwait(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) DisableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.DisableIcebergCompactionAwait(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetServerlessBackfill(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetServerlessBackfill(ctx, info.NsId)
+}
+
+// EnableServerlessBackfillAwait holds the rescale lock from the request until the cluster is
+// running again, like EnableIcebergCompactionAwait.
+func (c *CloudClient) EnableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest, accepted func()) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	if err := rs.EnableServerlessBackfill(ctx, info.NsId, req); err != nil {
+		return err
+	}
+	accepted()
+	return rs.WaitServerlessBackfillEnabled(ctx, info.NsId)
+}
+
+func (c *CloudClient) UpdateServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.UpdateServerlessBackfillAwait(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) DisableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.DisableServerlessBackfillAwait(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetComputeCache(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheResponseBody, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetComputeCache(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetComputeCacheCapabilities(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetComputeCacheCapabilities(ctx, info.NsId)
+}
+
+func (c *CloudClient) UpdateComputeCacheAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantComputeCacheRequestBody) error {
+	defer c.lockClusterRescale(nsID)()
+
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return err
+	}
+
+	return rs.UpdateComputeCacheAwait(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) GetClusterCloudMetadata(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.TenantCloudMetadata, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetClusterCloudMetadata(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetClusterEndpoint(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Endpoint, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.GetClusterEndpoint(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetClusterCACertificate(ctx context.Context, nsID uuid.UUID) (string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.GetClusterCACertificate(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetRootCACertificate(ctx context.Context, region string) (string, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return "", err
+	}
+	return rs.GetRootCACertificate(ctx)
+}
+
+func (c *CloudClient) CreateClusterOAuthToken(ctx context.Context, nsID uuid.UUID) (string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, nsID)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.CreateClusterOAuthToken(ctx, info.NsId)
+}
+
+func (c *CloudClient) GetClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) (*apigen_mgmtv2.DBUser, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	users, err := rs.GetClusterUsers(ctx, info.NsId)
+	if err != nil {
+		if errors.Is(err, ErrClusterNotFound) {
+			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s", clusterNsID.String())
+		}
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Username == username {
+			return ptr.Ptr(user), nil
+		}
+	}
+	return nil, errors.Errorf("user %s not found in cluster %s", username, clusterNsID.String())
+}
+
+func (c *CloudClient) CreateClusterUser(ctx context.Context, clusterNsID uuid.UUID, username, password string, createDB, superUser, createUser bool) (*apigen_mgmtv2.DBUser, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := rs.CreateClusterUser(ctx, info.NsId, apigen_mgmtv2.CreateDBUserRequestBody{
+		Username:   username,
+		Password:   password,
+		Createdb:   createDB,
+		Superuser:  superUser,
+		Createuser: ptr.Ptr(createUser),
+	})
+	if err != nil {
+		if errors.Is(err, ErrClusterNotFound) {
+			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s", clusterNsID.String())
+		}
+		return nil, err
+	}
+	return u, err
+}
+
+func (c *CloudClient) UpdateClusterUserPassword(ctx context.Context, clusterNsID uuid.UUID, username, password string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.UpdateClusterUserPassword(ctx, info.NsId, username, password)
+}
+
+func (c *CloudClient) DeleteClusterUser(ctx context.Context, clusterNsID uuid.UUID, username string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteClusterUser(ctx, info.NsId, username)
+}
+
+func (c *CloudClient) GetDatabase(ctx context.Context, clusterNsID uuid.UUID, name string) (*apigen_mgmtv2.Database, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	databases, err := rs.GetDatabases(ctx, info.NsId)
+	if err != nil {
+		return nil, err
+	}
+	for _, database := range databases {
+		if database.Name == name {
+			return ptr.Ptr(database), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", name, clusterNsID.String())
+}
+
+func (c *CloudClient) CreateDatabase(ctx context.Context, clusterNsID uuid.UUID, name, resourceGroup string) (*apigen_mgmtv2.Database, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.CreateDatabase(ctx, info.NsId, apigen_mgmtv2.CreateDatabaseRequestBody{
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	})
+}
+
+func (c *CloudClient) DeleteDatabase(ctx context.Context, clusterNsID uuid.UUID, name string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteDatabase(ctx, info.NsId, name)
+}
+
+func (c *CloudClient) GetSecret(ctx context.Context, clusterNsID uuid.UUID, database, name string) (*apigen_mgmtv2.Secret, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := rs.GetSecrets(ctx, info.NsId, database)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.Name == name {
+			return ptr.Ptr(secret), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrSecretNotFound, "secret %s in database %s of cluster %s", name, database, clusterNsID.String())
+}
+
+func (c *CloudClient) CreateSecret(ctx context.Context, clusterNsID uuid.UUID, database, name, value string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.CreateSecret(ctx, info.NsId, database, apigen_mgmtv2.CreateSecretRequestBody{
+		Name:  name,
+		Value: value,
+	})
+}
+
+func (c *CloudClient) DeleteSecret(ctx context.Context, clusterNsID uuid.UUID, database, name string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteSecret(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) GetSecretReferences(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetSecretReferences(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) ExecuteSQLBatch(ctx context.Context, clusterNsID uuid.UUID, database string, queries []string) ([]apigen_mgmtv2.BatchQueryResult, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.ExecuteSQLBatch(ctx, info.NsId, database, queries)
+}
+
+func (c *CloudClient) QuerySQL(ctx context.Context, clusterNsID uuid.UUID, database, query string) (*apigen_mgmtv2.QueryResult, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.QuerySQL(ctx, info.NsId, database, query)
+}
+
+func (c *CloudClient) GetMatView(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.MatView, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	matViews, err := rs.GetMatViews(ctx, info.NsId, database, schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, matView := range matViews {
+		if matView.MatViewName == name {
+			return ptr.Ptr(matView), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrMatViewNotFound, "materialized view %s.%s in database %s of cluster %s", schema, name, database, clusterNsID.String())
+}
+
+func (c *CloudClient) WaitMatViewBackfilled(ctx context.Context, clusterNsID uuid.UUID, database, name string, timeout time.Duration) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.WaitMatViewBackfilled(ctx, info.NsId, database, name, timeout)
+}
+
+func (c *CloudClient) GetMatViewDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetMatViewDownstreams(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) DeleteMatView(ctx context.Context, clusterNsID uuid.UUID, database, name string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteMatView(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) GetSource(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SourceInfo, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := rs.GetSources(ctx, info.NsId, database, schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, source := range sources {
+		if source.SourceName == name {
+			return ptr.Ptr(source), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrSourceNotFound, "source %s.%s in database %s of cluster %s", schema, name, database, clusterNsID.String())
+}
+
+func (c *CloudClient) GetSourceDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetSourceDownstreams(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) DeleteSource(ctx context.Context, clusterNsID uuid.UUID, database, name string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteSource(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) GetSink(ctx context.Context, clusterNsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SinkInfo, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	sinks, err := rs.GetSinks(ctx, info.NsId, database, schema)
+	if err != nil {
+		return nil, err
+	}
+	for _, sink := range sinks {
+		if sink.SinkName == name {
+			return ptr.Ptr(sink), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrSinkNotFound, "sink %s.%s in database %s of cluster %s", schema, name, database, clusterNsID.String())
+}
+
+func (c *CloudClient) GetSinkDownstreams(ctx context.Context, clusterNsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetSinkDownstreams(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) DeleteSink(ctx context.Context, clusterNsID uuid.UUID, database, name string) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteSink(ctx, info.NsId, database, name)
+}
+
+func (c *CloudClient) PingSource(ctx context.Context, clusterNsID uuid.UUID, req apigen_mgmtv2.PostSourcesPingRequestBody) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.PingSource(ctx, info.NsId, req)
+}
+
+func (c *CloudClient) FetchKafkaTopics(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.KafkaConfig) ([]string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchKafkaTopics(ctx, info.NsId, config)
+}
+
+func (c *CloudClient) FetchKafkaSchema(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.KafkaConfig, topic string) ([]apigen_mgmtv2.ColumnDesc, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchKafkaSchema(ctx, info.NsId, config, topic)
+}
+
+func (c *CloudClient) FetchPostgresTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.PostgresCdcConfig) ([]string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchPostgresTables(ctx, info.NsId, config)
+}
+
+func (c *CloudClient) FetchMysqlTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.MysqlCdcConfig) ([]string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchMysqlTables(ctx, info.NsId, config)
+}
+
+func (c *CloudClient) FetchSqlserverTables(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.SqlserverCdcConfig) ([]string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchSqlserverTables(ctx, info.NsId, config)
+}
+
+func (c *CloudClient) FetchMongodbCollections(ctx context.Context, clusterNsID uuid.UUID, config apigen_mgmtv2.MongodbCdcConfig) ([]string, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.FetchMongodbCollections(ctx, info.NsId, config)
+}
+
+type PrivateLinkInfo struct {
+	ClusterNsID uuid.UUID
+	PrivateLink *apigen_mgmtv2.PrivateLink
+}
+
+func (c *CloudClient) GetPrivateLinks(ctx context.Context) ([]PrivateLinkInfo, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 10
+	)
+	var privateLinks []apigen_acc.PrivateLink
+	for {
+		res, err := c.accClient.GetPrivatelinksWithResponse(ctx, &apigen_acc.GetPrivatelinksParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call API get private links")
+		}
+		if err = apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		offset = res.JSON200.Offset
+		limit = res.JSON200.Limit
+		privateLinks = append(privateLinks, res.JSON200.PrivateLinks...)
+		if limit*(offset+1) >= res.JSON200.Size {
+			break
+		}
+		offset++
+	}
+	var plInfos []PrivateLinkInfo
+	for _, accpl := range privateLinks {
+		rs, err := c.getRegionClient(accpl.Region)
+		if err != nil {
+			return nil, err
+		}
+		pl, err := rs.GetPrivateLink(ctx, accpl.TenantNsId, accpl.Id)
+		if err != nil {
+			return nil, err
+		}
+		cluster, err := rs.GetClusterByNsID(ctx, accpl.TenantNsId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get cluster through id %d provided by private link with ID %s", accpl.TenantId, pl.Id.String())
+		}
+		plInfos = append(plInfos, PrivateLinkInfo{
+			ClusterNsID: cluster.NsId,
+			PrivateLink: pl,
+		})
+	}
+	return plInfos, nil
+}
+
+func (c *CloudClient) GetPrivateLink(ctx context.Context, privateLinkID uuid.UUID) (*PrivateLinkInfo, error) {
+	pls, err := c.GetPrivateLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pl := range pls {
+		if pl.PrivateLink.Id == privateLinkID {
+			return &pl, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrPrivateLinkNotFound, "private link %s", privateLinkID.String())
+}
+
+func (c *CloudClient) CreatePrivateLinkAwait(ctx context.Context, clusterNsID uuid.UUID, req apigen_mgmtv2.PostPrivateLinkRequestBody) (*PrivateLinkInfo, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	pl, err := rs.CreatePrivateLinkAwait(ctx, info.NsId, req)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateLinkInfo{
+		ClusterNsID: info.NsId,
+		PrivateLink: pl,
+	}, nil
+}
+
+func (c *CloudClient) DeletePrivateLinkAwait(ctx context.Context, clusterNsID uuid.UUID, privateLinkID uuid.UUID) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeletePrivateLinkAwait(ctx, info.NsId, privateLinkID)
+}
+
+func (c *CloudClient) GetClusterByRegionAndName(ctx context.Context, region string, name string) (*apigen_mgmtv2.Tenant, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
 	}
 
 	tenantV1, err := rs.GetClusterByName(ctx, name)
@@ -505,6 +1442,86 @@ func (c *CloudClient) GetBYOCCluster(ctx context.Context, region string, name st
 	return rs.GetBYOCCluster(ctx, name)
 }
 
+func (c *CloudClient) CreateBYOCCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByocClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+	return rs.CreateBYOCCluster(ctx, req)
+}
+
+func (c *CloudClient) WaitBYOCClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+	return rs.WaitBYOCClusterReady(ctx, name)
+}
+
+func (c *CloudClient) UpdateBYOCCluster(ctx context.Context, region string, name string, req apigen_mgmtv2.PutByocClusterRequestBody) error {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return err
+	}
+	return rs.UpdateBYOCCluster(ctx, name, req)
+}
+
+func (c *CloudClient) UpdateBYOCClusterVersionAwait(ctx context.Context, region string, name, version string) error {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return err
+	}
+	return rs.UpdateBYOCClusterVersionAwait(ctx, name, version)
+}
+
+func (c *CloudClient) DeleteBYOCClusterAwait(ctx context.Context, region string, name string) error {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteBYOCClusterAwait(ctx, name)
+}
+
+func (c *CloudClient) GetBYOKCluster(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetBYOKCluster(ctx, name)
+}
+
+func (c *CloudClient) CreateBYOKCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByokClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+	return rs.CreateBYOKCluster(ctx, req)
+}
+
+func (c *CloudClient) WaitBYOKClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return nil, err
+	}
+	return rs.WaitBYOKClusterReady(ctx, name)
+}
+
+func (c *CloudClient) UpdateBYOKClusterVersionAwait(ctx context.Context, region string, name, version string) error {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return err
+	}
+	return rs.UpdateBYOKClusterVersionAwait(ctx, name, version)
+}
+
+func (c *CloudClient) DeleteBYOKClusterAwait(ctx context.Context, region string, name string) error {
+	rs, err := c.getRegionClient(region)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteBYOKClusterAwait(ctx, name)
+}
+
 func (c *CloudClient) GetResourceGroup(ctx context.Context, clusterNsID uuid.UUID, name string) (*apigen_mgmtv2.ResourceGroupDetails, error) {
 	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
 	if err != nil {
@@ -575,3 +1592,262 @@ func (c *CloudClient) RemoveAllowedIamRoleAwait(ctx context.Context, clusterNsID
 	}
 	return rs.RemoveAllowedIamRoleAwait(ctx, info.NsId, roleArn)
 }
+
+func (c *CloudClient) GetBackupSnapshot(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.GetBackupSnapshot(ctx, info.NsId, snapshotID)
+}
+
+func (c *CloudClient) CreateBackupSnapshot(ctx context.Context, clusterNsID uuid.UUID) (uuid.UUID, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return rs.CreateBackupSnapshot(ctx, info.NsId)
+}
+
+func (c *CloudClient) WaitBackupSnapshotCompleted(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return rs.WaitBackupSnapshotCompleted(ctx, info.NsId, snapshotID)
+}
+
+func (c *CloudClient) DeleteBackupSnapshotAwait(ctx context.Context, clusterNsID, snapshotID uuid.UUID) error {
+	info, rs, err := c.getClusterInfoAndRegionClient(ctx, clusterNsID)
+	if err != nil {
+		return err
+	}
+	return rs.DeleteBackupSnapshotAwait(ctx, info.NsId, snapshotID)
+}
+
+func (c *CloudClient) GetServiceAccount(ctx context.Context, id uuid.UUID) (*apigen_accv2.ServiceAccount, error) {
+	res, err := c.accV2Client.GetServiceAccountsIdWithResponse(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the service account")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrServiceAccountNotFound, "service account %s", id)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *CloudClient) CreateServiceAccount(ctx context.Context, name, description string, roleID uuid.UUID) (*apigen_accv2.ServiceAccount, error) {
+	res, err := c.accV2Client.PostServiceAccountsWithResponse(ctx, apigen_accv2.PostServiceAccountRequestBody{
+		Name:        name,
+		Description: description,
+		RoleId:      roleID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to create the service account")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *CloudClient) UpdateServiceAccountDescription(ctx context.Context, id uuid.UUID, description string) error {
+	res, err := c.accV2Client.PutServiceAccountsIdWithResponse(ctx, id, apigen_accv2.PutServiceAccountRequestBody{
+		Description: description,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to update the service account")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrServiceAccountNotFound, "service account %s", id)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *CloudClient) DeleteServiceAccount(ctx context.Context, id uuid.UUID) error {
+	res, err := c.accV2Client.DeleteServiceAccountsIdWithResponse(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to delete the service account")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *CloudClient) GetAPIKey(ctx context.Context, id uint64) (*apigen_accv2.ApiKey, error) {
+	res, err := c.accV2Client.GetApiKeysIdWithResponse(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the API key")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrAPIKeyNotFound, "API key %d", id)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *CloudClient) CreateAPIKey(ctx context.Context, principal uuid.UUID, description string) (*apigen_accv2.CreatedApiKey, error) {
+	res, err := c.accV2Client.PostApiKeysWithResponse(ctx, apigen_accv2.PostApiKeyRequestBody{
+		Principal:   principal,
+		Description: description,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to create the API key")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *CloudClient) UpdateAPIKeyDescription(ctx context.Context, id uint64, description string) (*apigen_accv2.ApiKey, error) {
+	res, err := c.accV2Client.PutApiKeysIdWithResponse(ctx, id, apigen_accv2.PutApiKeyRequestBody{
+		Description: description,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to update the API key")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrAPIKeyNotFound, "API key %d", id)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *CloudClient) DeleteAPIKey(ctx context.Context, id uint64) error {
+	res, err := c.accV2Client.DeleteApiKeysIdWithResponse(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to delete the API key")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *CloudClient) GetRoles(ctx context.Context) ([]apigen_accv2.Role, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 100
+	)
+	var rtn []apigen_accv2.Role
+	for {
+		res, err := c.accV2Client.GetRolesWithResponse(ctx, &apigen_accv2.GetRolesParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call API to get roles")
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Roles...)
+		offset += uint64(len(res.JSON200.Roles))
+		if len(res.JSON200.Roles) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *CloudClient) GetRoleBindings(ctx context.Context, principal *uuid.UUID) ([]apigen_accv2.RoleBinding, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 100
+	)
+	var rtn []apigen_accv2.RoleBinding
+	for {
+		res, err := c.accV2Client.GetRoleBindingsWithResponse(ctx, &apigen_accv2.GetRoleBindingsParams{
+			Offset:    &offset,
+			Limit:     &limit,
+			Principal: principal,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call API to get role bindings")
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.RoleBindings...)
+		offset += uint64(len(res.JSON200.RoleBindings))
+		if len(res.JSON200.RoleBindings) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *CloudClient) UpdateRoleBindings(ctx context.Context, principal uuid.UUID, principalType string, roleIDs []uuid.UUID) error {
+	unlock := c.lockRoleBindings(principal)
+	defer unlock()
+
+	return c.putRoleBindings(ctx, principal, principalType, roleIDs)
+}
+
+func (c *CloudClient) AddRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error {
+	unlock := c.lockRoleBindings(principal)
+	defer unlock()
+
+	bindings, err := c.GetRoleBindings(ctx, &principal)
+	if err != nil {
+		return err
+	}
+	roleIDs := []uuid.UUID{}
+	for _, b := range bindings {
+		if b.RoleId == roleID {
+			return nil
+		}
+		roleIDs = append(roleIDs, b.RoleId)
+	}
+	return c.putRoleBindings(ctx, principal, principalType, append(roleIDs, roleID))
+}
+
+func (c *CloudClient) RemoveRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error {
+	unlock := c.lockRoleBindings(principal)
+	defer unlock()
+
+	bindings, err := c.GetRoleBindings(ctx, &principal)
+	if err != nil {
+		return err
+	}
+	var (
+		roleIDs = []uuid.UUID{}
+		bound   = false
+	)
+	for _, b := range bindings {
+		if b.RoleId == roleID {
+			bound = true
+			continue
+		}
+		roleIDs = append(roleIDs, b.RoleId)
+	}
+	if !bound {
+		return nil
+	}
+	return c.putRoleBindings(ctx, principal, principalType, roleIDs)
+}
+
+// putRoleBindings replaces the roles of the principal, the caller holds the lock of the principal.
+func (c *CloudClient) putRoleBindings(ctx context.Context, principal uuid.UUID, principalType string, roleIDs []uuid.UUID) error {
+	res, err := c.accV2Client.PutRoleBindingsWithResponse(ctx, apigen_accv2.PutRoleBindingRequestBody{
+		Principal:     principal,
+		PrincipalType: principalType,
+		RoleIds:       roleIDs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to update the role bindings of %s", principal)
+	}
+	if res.JSON400 != nil {
+		return errors.Errorf("invalid role bindings of %s %s: %s", principalType, principal, res.JSON400.Msg)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
@@ -0,0 +1,206 @@
+package cloudsdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	apigen_acc "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v1"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCloudClient(t *testing.T, handler http.Handler) *CloudClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	accV2Client, err := apigen_accv2.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return &CloudClient{accV2Client: accV2Client}
+}
+
+// newTestClusterClient returns a client that finds the cluster in the region served by rs.
+func newTestClusterClient(t *testing.T, nsID uuid.UUID, rs RegionServiceClientInterface) *CloudClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tenant/"+nsID.String(), r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(apigen_acc.Tenant{NsId: nsID, Region: "us-east-1"}))
+	}))
+	t.Cleanup(server.Close)
+
+	accClient, err := apigen_acc.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	return &CloudClient{accClient: accClient, regions: map[string]RegionServiceClientInterface{"us-east-1": rs}}
+}
+
+// assertRescaleLocked fails unless the rescale lock of the cluster is held.
+func assertRescaleLocked(t *testing.T, c *CloudClient, nsID uuid.UUID) {
+	t.Helper()
+
+	v, ok := c.rescaleLocks.Load(nsID)
+	require.True(t, ok)
+	mu, ok := v.(*sync.Mutex)
+	require.True(t, ok)
+	if mu.TryLock() {
+		mu.Unlock()
+		t.Error("the rescale lock of the cluster is not held")
+	}
+}
+
+// extensionRegionClient records the calls enabling an extension.
+type extensionRegionClient struct {
+	RegionServiceClientInterface
+
+	onCall func(call string)
+}
+
+func (c *extensionRegionClient) EnableIcebergCompaction(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) error {
+	c.onCall("enable")
+	return nil
+}
+
+func (c *extensionRegionClient) WaitIcebergCompactionRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error) {
+	c.onCall("wait")
+	return &apigen_mgmtv2.IcebergCompaction{}, nil
+}
+
+func (c *extensionRegionClient) EnableServerlessBackfill(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error {
+	c.onCall("enable")
+	return nil
+}
+
+func (c *extensionRegionClient) WaitServerlessBackfillEnabled(ctx context.Context, nsID uuid.UUID) error {
+	c.onCall("wait")
+	return nil
+}
+
+// The lock is held from the request to the end of the wait, so no rescale of the same cluster
+// can start in between.
+func TestEnableIcebergCompactionAwaitHoldsRescaleLock(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	var (
+		client *CloudClient
+		calls  []string
+	)
+	rs := &extensionRegionClient{onCall: func(call string) {
+		assertRescaleLocked(t, client, nsID)
+		calls = append(calls, call)
+	}}
+	client = newTestClusterClient(t, nsID, rs)
+
+	_, err := client.EnableIcebergCompactionAwait(context.Background(), nsID, apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody{}, func() {
+		rs.onCall("accepted")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"enable", "accepted", "wait"}, calls)
+}
+
+func TestEnableServerlessBackfillAwaitHoldsRescaleLock(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	var (
+		client *CloudClient
+		calls  []string
+	)
+	rs := &extensionRegionClient{onCall: func(call string) {
+		assertRescaleLocked(t, client, nsID)
+		calls = append(calls, call)
+	}}
+	client = newTestClusterClient(t, nsID, rs)
+
+	err := client.EnableServerlessBackfillAwait(context.Background(), nsID, apigen_mgmtv2.TenantExtensionServerlessBackfillRequest{}, func() {
+		rs.onCall("accepted")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"enable", "accepted", "wait"}, calls)
+}
+
+func TestAccV2Endpoint(t *testing.T) {
+	assert.Equal(t, "https://canary-useast2-acc.risingwave.cloud/api/v2", accV2Endpoint("https://canary-useast2-acc.risingwave.cloud/api/v1"))
+	assert.Equal(t, "https://canary-useast2-acc.risingwave.cloud/api/v2", accV2Endpoint("https://canary-useast2-acc.risingwave.cloud/api/v1/"))
+	assert.Equal(t, "http://localhost:8180/api/v2", accV2Endpoint("http://localhost:8180/api"))
+}
+
+func TestServiceAccountNotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewRandom())
+
+	client := newTestCloudClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/serviceAccounts/"+id.String(), r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	_, err := client.GetServiceAccount(context.Background(), id)
+	assert.True(t, errors.Is(err, ErrServiceAccountNotFound))
+
+	// deleting a service account that is gone already is not an error.
+	assert.NoError(t, client.DeleteServiceAccount(context.Background(), id))
+}
+
+func TestAPIKeyNotFound(t *testing.T) {
+	client := newTestCloudClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/apiKeys/42", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	_, err := client.GetAPIKey(context.Background(), 42)
+	assert.True(t, errors.Is(err, ErrAPIKeyNotFound))
+
+	// deleting an API key that is gone already is not an error.
+	assert.NoError(t, client.DeleteAPIKey(context.Background(), 42))
+}
+
+func TestAddRoleBinding(t *testing.T) {
+	var (
+		principal     = uuid.Must(uuid.NewRandom())
+		existing      = uuid.Must(uuid.NewRandom())
+		added         = uuid.Must(uuid.NewRandom())
+		principalType = "ServiceAccount"
+		put           *apigen_accv2.PutRoleBindingRequestBody
+	)
+
+	client := newTestCloudClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/roleBindings", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, principal.String(), r.URL.Query().Get("principal"))
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(t, json.NewEncoder(w).Encode(apigen_accv2.RoleBindingPagination{
+				Pagination:   &apigen_accv2.Pagination{Size: 1},
+				RoleBindings: []apigen_accv2.RoleBinding{{Principal: principal, PrincipalType: &principalType, RoleId: existing}},
+			}))
+		case http.MethodPut:
+			put = &apigen_accv2.PutRoleBindingRequestBody{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(put))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"msg":"ok"}`))
+		}
+	}))
+
+	// the roles of the principal are replaced at once, so the existing one is sent along.
+	require.NoError(t, client.AddRoleBinding(context.Background(), principal, principalType, added))
+	require.NotNil(t, put)
+	assert.Equal(t, apigen_accv2.PutRoleBindingRequestBody{
+		Principal:     principal,
+		PrincipalType: principalType,
+		RoleIds:       []uuid.UUID{existing, added},
+	}, *put)
+
+	// binding a role twice is a no-op.
+	put = nil
+	require.NoError(t, client.AddRoleBinding(context.Background(), principal, principalType, existing))
+	assert.Nil(t, put)
+}
@@ -2,8 +2,15 @@ package fake
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"log"
+	"math/big"
 	"math/rand"
 	"os"
 	"regexp"
@@ -15,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
 	apigen_mgmtv1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
@@ -92,232 +100,1095 @@ func (acc *FakeCloudClient) CreateClusterAwait(ctx context.Context, region strin
 
 	r := state.GetRegionState(region)
 	t := &apigen_mgmtv2.Tenant{
-		Id:          uint64(len(r.GetClusters()) + 1),
-		TenantName:  req.TenantName,
-		ImageTag:    *req.ImageTag,
-		Region:      region,
-		RwConfig:    *req.RwConfig,
-		Resources:   reqResouceToClusterResource(req.Resources),
-		NsId:        uuid.New(),
-		Tier:        *req.Tier,
-		ClusterName: *clusterName,
+		Id:           uint64(len(r.GetClusters()) + 1),
+		TenantName:   req.TenantName,
+		ImageTag:     *req.ImageTag,
+		Region:       region,
+		RwConfig:     *req.RwConfig,
+		Resources:    reqResouceToClusterResource(req.Resources),
+		NsId:         uuid.New(),
+		Tier:         *req.Tier,
+		ClusterName:  *clusterName,
+		Status:       apigen_mgmtv2.Running,
+		HealthStatus: apigen_mgmtv2.Healthy,
 	}
 
 	cluster := NewClusterState(t)
+	if req.MaintenanceWindow != nil {
+		cluster.SetMaintenanceWindow(*req.MaintenanceWindow)
+	}
+	if req.Extensions != nil && req.Extensions.ServerlessCompaction != nil {
+		cluster.SetServerlessCompaction(req.Extensions.ServerlessCompaction)
+	}
 	r.AddCluster(cluster)
 	return t, nil
 }
 
-var availableComponentTypes = []apigen_mgmtv1.AvailableComponentType{
-	{
-		Id:      "p-1c4g",
-		Cpu:     "1",
-		Memory:  "4 GB",
-		Maximum: 3,
-	},
-	{
-		Id:      "p-2c8g",
-		Cpu:     "2",
-		Memory:  "8 GB",
-		Maximum: 3,
-	},
+func (acc *FakeCloudClient) CreateClusterAwaitingConfig(ctx context.Context, region string, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	debugFuncCaller()
+
+	t, err := acc.CreateClusterAwait(ctx, region, req)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := state.GetClusterByNsID(t.NsId)
+	if err != nil {
+		return nil, err
+	}
+	t.ServiceAccountName = ptr.Ptr(fmt.Sprintf("risingwave-%s", t.NsId))
+	cluster.SetStatus(apigen_mgmtv2.AwaitingConfig)
+	return t, nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterBYOKConfig(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	// the configuration is not reported back, so there is nothing to record.
+	cluster.SetStatus(apigen_mgmtv2.Running)
+	return cluster.GetTenant(), nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterBYOKConfigAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) error {
+	debugFuncCaller()
+
+	_, err := acc.UpdateClusterBYOKConfig(ctx, nsID, req)
+	return err
+}
+
+func (acc *FakeCloudClient) RestoreCluster(ctx context.Context, nsID, snapshotID uuid.UUID, newClusterName string) (uuid.UUID, error) {
+	debugFuncCaller()
+
+	source, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if _, err := source.GetBackupSnapshot(snapshotID); err != nil {
+		return uuid.Nil, err
+	}
+
+	// the restored cluster starts as a copy of the source, reconciling it is up to the caller.
+	sourceTenant := source.GetTenant()
+	r := state.GetRegionState(sourceTenant.Region)
+	t := *sourceTenant
+	t.Id = uint64(len(r.GetClusters()) + 1)
+	t.TenantName = newClusterName
+	t.NsId = uuid.New()
+
+	r.AddCluster(NewClusterState(&t))
+	return t.NsId, nil
+}
+
+func (acc *FakeCloudClient) WaitClusterRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Tenant, error) {
+	debugFuncCaller()
+
+	// clusters are running as soon as they are created in the fake backend.
+	return acc.GetClusterByNsID(ctx, nsID)
+}
+
+func (acc *FakeCloudClient) InPlaceRestoreClusterAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if status := cluster.GetTenant().Status; status != apigen_mgmtv2.Running {
+		return errors.Wrapf(cloudsdk.ErrClusterNotRunning, "cluster %s, current status: %s", nsID, status)
+	}
+	// the fake backend keeps no data to roll back, the snapshot only has to exist.
+	_, err = cluster.GetBackupSnapshot(snapshotID)
+	return err
+}
+
+func (acc *FakeCloudClient) GetClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.MaintenanceWindow, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return ptr.Ptr(cluster.GetMaintenanceWindow()), nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterMaintenanceWindow(ctx context.Context, nsID uuid.UUID, window apigen_mgmtv2.MaintenanceWindow) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetMaintenanceWindow(window)
+	return nil
+}
+
+func (acc *FakeCloudClient) StopClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if status := cluster.GetTenant().Status; status != apigen_mgmtv2.Running {
+		return errors.Wrapf(cloudsdk.ErrClusterNotRunning, "cluster %s, current status: %s", nsID, status)
+	}
+	cluster.SetStatus(apigen_mgmtv2.Stopped)
+	return nil
+}
+
+func (acc *FakeCloudClient) StartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if status := cluster.GetTenant().Status; status != apigen_mgmtv2.Stopped {
+		return errors.Errorf("cluster %s is not stopped, current status: %s", nsID, status)
+	}
+	cluster.SetStatus(apigen_mgmtv2.Running)
+	return nil
+}
+
+func (acc *FakeCloudClient) RestartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	// the fake backend runs no nodes, there is nothing to restart.
+	if status := cluster.GetTenant().Status; status != apigen_mgmtv2.Running {
+		return errors.Wrapf(cloudsdk.ErrClusterNotRunning, "cluster %s, current status: %s", nsID, status)
+	}
+	return nil
+}
+
+func (acc *FakeCloudClient) GetServerlessCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.GetServerlessCompaction(), nil
+}
+
+func (acc *FakeCloudClient) EnableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetServerlessCompaction(&req)
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetServerlessCompaction(&req)
+	return nil
+}
+
+func (acc *FakeCloudClient) DisableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetServerlessCompaction(nil)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetIcebergCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.GetIcebergCompaction()
+}
+
+// EnableIcebergCompactionAwait returns right away, the extension is running as soon as it is
+// enabled in the fake backend.
+func (acc *FakeCloudClient) EnableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody, accepted func()) (*apigen_mgmtv2.IcebergCompaction, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cluster.GetIcebergCompaction(); err == nil {
+		return nil, errors.Errorf("iceberg compaction is already enabled in cluster %s", nsID)
+	}
+	cluster.SetIcebergCompaction(&apigen_mgmtv2.IcebergCompaction{
+		Config:    req.Config,
+		Resources: componentReqToComponent(req.Resources),
+		Status:    cloudsdk.IcebergCompactionStatusRunning,
+	})
+	accepted()
+	return cluster.GetIcebergCompaction()
+}
+
+func (acc *FakeCloudClient) UpdateIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen_mgmtv2.IcebergCompaction, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	compaction, err := cluster.GetIcebergCompaction()
+	if err != nil {
+		return nil, err
+	}
+	if req.Config != nil {
+		compaction.Config = req.Config
+	}
+	if req.Resources != nil {
+		compaction.Resources = componentReqToComponent(req.Resources)
+	}
+	cluster.SetIcebergCompaction(compaction)
+	return cluster.GetIcebergCompaction()
+}
+
+func (acc *FakeCloudClient) DisableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetIcebergCompaction(nil)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetServerlessBackfill(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.GetServerlessBackfill()
+}
+
+func (acc *FakeCloudClient) EnableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest, accepted func()) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if _, err := cluster.GetServerlessBackfill(); err == nil {
+		return errors.Errorf("serverless backfill is already enabled in cluster %s", nsID)
+	}
+	cluster.SetServerlessBackfill(&apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody{
+		Resources: componentReqToComponent(&req.Resources),
+		Status:    "Running",
+	})
+	accepted()
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if _, err := cluster.GetServerlessBackfill(); err != nil {
+		return err
+	}
+	cluster.SetServerlessBackfill(&apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody{
+		Resources: componentReqToComponent(&req.Resources),
+		Status:    "Running",
+	})
+	return nil
+}
+
+func (acc *FakeCloudClient) DisableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.SetServerlessBackfill(nil)
+	return nil
+}
+
+var computeCacheCapabilities = apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody{
+	AvailablePerformanceTiers: []apigen_mgmtv2.TenantComputeCachePerformanceTier{
+		{Id: apigen_mgmtv2.ComputeCachePerformanceTierStandard, Label: "Standard", Default: true},
+		{Id: apigen_mgmtv2.ComputeCachePerformanceTierPerformance, Label: "Performance"},
+	},
+	DefaultConfig: apigen_mgmtv2.TenantComputeCacheConfig{
+		SizeGb:          20,
+		PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierStandard),
+	},
+	MinSizeGb:  10,
+	MaxSizeGb:  500,
+	SizeStepGb: 10,
+}
+
+func (acc *FakeCloudClient) GetComputeCache(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheResponseBody, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return &apigen_mgmtv2.GetTenantComputeCacheResponseBody{
+		Current: cluster.GetComputeCache(),
+	}, nil
+}
+
+func (acc *FakeCloudClient) GetComputeCacheCapabilities(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, error) {
+	debugFuncCaller()
+
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
+	}
+	ret := computeCacheCapabilities
+	return &ret, nil
+}
+
+func (acc *FakeCloudClient) UpdateComputeCacheAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantComputeCacheRequestBody) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if req.SizeGb < computeCacheCapabilities.MinSizeGb || req.SizeGb > computeCacheCapabilities.MaxSizeGb {
+		return errors.Errorf("compute cache size %d GB is out of range", req.SizeGb)
+	}
+	tier := cluster.GetComputeCache().PerformanceTier
+	if req.PerformanceTier != nil {
+		tier = req.PerformanceTier
+	}
+	cluster.SetComputeCache(req.SizeGb, *tier)
+	return nil
+}
+
+// GetClusterCloudMetadata returns the metadata of a cluster on AWS.
+func (acc *FakeCloudClient) GetClusterCloudMetadata(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.TenantCloudMetadata, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	var network apigen_mgmtv2.TenantCloudMetadataNetwork
+	if err := network.FromTenantCloudMetadataNetworkAWS(apigen_mgmtv2.TenantCloudMetadataNetworkAWS{
+		NatGatewayIps:         []string{"203.0.113.10", "203.0.113.11"},
+		PrivateLinkPrincipals: &[]string{"arn:aws:iam::123456789012:root"},
+	}); err != nil {
+		return nil, err
+	}
+	var iam apigen_mgmtv2.TenantCloudMetadataIAM
+	if err := iam.FromTenantCloudMetadataIAMAWS(apigen_mgmtv2.TenantCloudMetadataIAMAWS{
+		WorkloadRoleArn: fmt.Sprintf("arn:aws:iam::123456789012:role/risingwave-%s", nsID),
+	}); err != nil {
+		return nil, err
+	}
+	return &apigen_mgmtv2.TenantCloudMetadata{
+		CloudProvider: "aws",
+		Region:        cluster.GetTenant().Region,
+		Network:       &network,
+		Iam:           &iam,
+	}, nil
+}
+
+func (acc *FakeCloudClient) GetClusterEndpoint(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Endpoint, error) {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	tenant := cluster.GetTenant()
+	return &apigen_mgmtv2.Endpoint{
+		NsId:         nsID,
+		Host:         fmt.Sprintf("%s.%s.risingwave.cloud", tenant.TenantName, tenant.Region),
+		Port:         4566,
+		Database:     "dev",
+		InternalHost: fmt.Sprintf("risingwave-frontend.rwc-%s.svc", nsID),
+		InternalPort: 4566,
+	}, nil
+}
+
+func (acc *FakeCloudClient) GetClusterCACertificate(ctx context.Context, nsID uuid.UUID) (string, error) {
+	debugFuncCaller()
+
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return "", err
+	}
+	return selfSignedCertificate(fmt.Sprintf("rwc-%s", nsID))
+}
+
+func (acc *FakeCloudClient) GetRootCACertificate(ctx context.Context, region string) (string, error) {
+	debugFuncCaller()
+
+	return selfSignedCertificate(fmt.Sprintf("RisingWave Cloud %s Root CA", region))
+}
+
+func (acc *FakeCloudClient) CreateClusterOAuthToken(ctx context.Context, nsID uuid.UUID) (string, error) {
+	debugFuncCaller()
+
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return "", err
+	}
+	return uuid.NewString(), nil
+}
+
+// selfSignedCertificate returns a PEM encoded CA certificate valid for a year.
+func selfSignedCertificate(commonName string) (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), crand.Reader)
+	if err != nil {
+		return "", err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(crand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+var availableComponentTypes = []apigen_mgmtv1.AvailableComponentType{
+	{
+		Id:      "p-1c4g",
+		Cpu:     "1",
+		Memory:  "4 GB",
+		Maximum: 3,
+	},
+	{
+		Id:      "p-2c8g",
+		Cpu:     "2",
+		Memory:  "8 GB",
+		Maximum: 3,
+	},
+}
+
+var availableMetaStore = &apigen_mgmtv1.AvailableMetaStore{
+	Postgresql: &apigen_mgmtv1.AvailableMetaStorePostgreSql{
+		MaximumSizeGiB: 20,
+		Nodes:          availableComponentTypes,
+	},
+	SharingPg: &apigen_mgmtv1.AvailableMetaStoreSharingPg{
+		Enabled: ptr.Ptr(true),
+	},
+	AwsRds: &apigen_mgmtv1.AvailableMetaStoreAwsRds{
+		Enabled: ptr.Ptr(true),
+	},
+	AzrPostgres: &apigen_mgmtv1.AvailableMetaStoreAzrPostgres{
+		Enabled: ptr.Ptr(true),
+	},
+	GcpCloudsql: &apigen_mgmtv1.AvailableMetaStoreGcpCloudSql{
+		Enabled: ptr.Ptr(true),
+	},
+}
+
+func (acc *FakeCloudClient) GetTiers(ctx context.Context, _ string) ([]apigen_mgmtv1.Tier, error) {
+	return []apigen_mgmtv1.Tier{
+		{
+			Id:                       ptr.Ptr(apigen_mgmtv1.Standard),
+			AvailableStandaloneNodes: availableComponentTypes,
+			AvailableMetaStore:       availableMetaStore,
+		},
+		{
+			Id:                      ptr.Ptr(apigen_mgmtv1.BYOC),
+			AvailableMetaNodes:      availableComponentTypes,
+			AvailableComputeNodes:   availableComponentTypes,
+			AvailableCompactorNodes: availableComponentTypes,
+			AvailableFrontendNodes:  availableComponentTypes,
+			AvailableMetaStore:      availableMetaStore,
+		},
+		{
+			Id:                      ptr.Ptr(apigen_mgmtv1.Invited),
+			AvailableMetaNodes:      availableComponentTypes,
+			AvailableComputeNodes:   availableComponentTypes,
+			AvailableCompactorNodes: availableComponentTypes,
+			AvailableFrontendNodes:  availableComponentTypes,
+			AvailableMetaStore:      availableMetaStore,
+		},
+	}, nil
+}
+
+func (acc *FakeCloudClient) GetAvailableComponentTypes(ctx context.Context, region string, targetTier apigen_mgmtv1.TierId, component string) ([]apigen_mgmtv1.AvailableComponentType, error) {
+	tiers, err := acc.GetTiers(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	var tier *apigen_mgmtv1.Tier
+	for _, t := range tiers {
+		if t.Id == nil {
+			continue
+		}
+		if *t.Id == targetTier {
+			tier = ptr.Ptr(t)
+			break
+		}
+	}
+	if tier == nil {
+		return nil, errors.Errorf("tier %s not found", targetTier)
+	}
+	switch component {
+	case cloudsdk.ComponentCompute:
+		return tier.AvailableComputeNodes, nil
+	case cloudsdk.ComponentCompactor:
+		return tier.AvailableCompactorNodes, nil
+	case cloudsdk.ComponentFrontend:
+		return tier.AvailableFrontendNodes, nil
+	case cloudsdk.ComponentMeta:
+		return tier.AvailableMetaNodes, nil
+	case cloudsdk.ComponentStandalone:
+		return tier.AvailableStandaloneNodes, nil
+	}
+	return nil, errors.Errorf("component %s not found", component)
+}
+
+func (acc *FakeCloudClient) DeleteClusterByNsIDAwait(ctx context.Context, nsID uuid.UUID) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			return nil
+		}
+	}
+
+	state.GetRegionState(c.tenant.Region).DeleteCluster(nsID)
+
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterImageByNsIDAwait(ctx context.Context, nsID uuid.UUID, version string) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.GetTenant().ImageTag = version
+	r := state.GetRegionState(cluster.GetTenant().Region)
+
+	r.ReplaceCluster(nsID, cluster)
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterResourcesByNsIDAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantResourcesRequestBody) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.GetTenant().Resources.Components.Compactor = componentReqToComponent(req.Compactor)
+	cluster.GetTenant().Resources.Components.Compute = componentReqToComponent(req.Compute)
+	cluster.GetTenant().Resources.Components.Frontend = componentReqToComponent(req.Frontend)
+	cluster.GetTenant().Resources.Components.Meta = componentReqToComponent(req.Meta)
+	cluster.GetTenant().Resources.Components.Standalone = componentReqToComponent(req.Standalone)
+	r := state.GetRegionState(cluster.GetTenant().Region)
+
+	r.ReplaceCluster(nsID, cluster)
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateRisingWaveConfigByNsIDAwait(ctx context.Context, nsID uuid.UUID, rwConfig string) error {
+	debugFuncCaller()
+
+	cluster, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	cluster.GetTenant().RwConfig = rwConfig
+	r := state.GetRegionState(cluster.GetTenant().Region)
+	r.ReplaceCluster(nsID, cluster)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetClusterUser(ctx context.Context, nsID uuid.UUID, username string) (*apigen_mgmtv2.DBUser, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetClusterUser(username)
+}
+
+func (acc *FakeCloudClient) CreateClusterUser(ctx context.Context, nsID uuid.UUID, username, password string, createDB, superUser, createUser bool) (*apigen_mgmtv2.DBUser, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbuser := &apigen_mgmtv2.DBUser{
+		Usecreatedb:   createDB,
+		Username:      username,
+		Usesysid:      uint64((time.Now().Unix() << 10) + int64(rand.Int31n(1024))),
+		Usesuper:      superUser,
+		Usecreateuser: createUser,
+		// the platform creates users with CREATE USER, which implies LOGIN.
+		Canlogin: true,
+	}
+
+	c.AddClusterUser(dbuser)
+
+	return dbuser, nil
+}
+
+func (acc *FakeCloudClient) UpdateClusterUserPassword(ctx context.Context, nsID uuid.UUID, username, password string) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.GetClusterUser(username)
+	return err
 }
 
-var availableMetaStore = &apigen_mgmtv1.AvailableMetaStore{
-	Postgresql: &apigen_mgmtv1.AvailableMetaStorePostgreSql{
-		MaximumSizeGiB: 20,
-		Nodes:          availableComponentTypes,
-	},
-	SharingPg: &apigen_mgmtv1.AvailableMetaStoreSharingPg{
-		Enabled: ptr.Ptr(true),
-	},
-	AwsRds: &apigen_mgmtv1.AvailableMetaStoreAwsRds{
-		Enabled: ptr.Ptr(true),
-	},
-	AzrPostgres: &apigen_mgmtv1.AvailableMetaStoreAzrPostgres{
-		Enabled: ptr.Ptr(true),
-	},
-	GcpCloudsql: &apigen_mgmtv1.AvailableMetaStoreGcpCloudSql{
-		Enabled: ptr.Ptr(true),
-	},
+func (acc *FakeCloudClient) DeleteClusterUser(ctx context.Context, nsID uuid.UUID, username string) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+
+	c.DeleteClusterUser(username)
+
+	return nil
 }
 
-func (acc *FakeCloudClient) GetTiers(ctx context.Context, _ string) ([]apigen_mgmtv1.Tier, error) {
-	return []apigen_mgmtv1.Tier{
-		{
-			Id:                       ptr.Ptr(apigen_mgmtv1.Standard),
-			AvailableStandaloneNodes: availableComponentTypes,
-			AvailableMetaStore:       availableMetaStore,
-		},
-		{
-			Id:                      ptr.Ptr(apigen_mgmtv1.BYOC),
-			AvailableMetaNodes:      availableComponentTypes,
-			AvailableComputeNodes:   availableComponentTypes,
-			AvailableCompactorNodes: availableComponentTypes,
-			AvailableFrontendNodes:  availableComponentTypes,
-			AvailableMetaStore:      availableMetaStore,
-		},
-		{
-			Id:                      ptr.Ptr(apigen_mgmtv1.Invited),
-			AvailableMetaNodes:      availableComponentTypes,
-			AvailableComputeNodes:   availableComponentTypes,
-			AvailableCompactorNodes: availableComponentTypes,
-			AvailableFrontendNodes:  availableComponentTypes,
-			AvailableMetaStore:      availableMetaStore,
-		},
-	}, nil
+func (acc *FakeCloudClient) GetDatabase(ctx context.Context, nsID uuid.UUID, name string) (*apigen_mgmtv2.Database, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDatabase(name)
 }
 
-func (acc *FakeCloudClient) GetAvailableComponentTypes(ctx context.Context, region string, targetTier apigen_mgmtv1.TierId, component string) ([]apigen_mgmtv1.AvailableComponentType, error) {
-	tiers, err := acc.GetTiers(ctx, region)
+func (acc *FakeCloudClient) CreateDatabase(ctx context.Context, nsID uuid.UUID, name, resourceGroup string) (*apigen_mgmtv2.Database, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
 		return nil, err
 	}
-	var tier *apigen_mgmtv1.Tier
-	for _, t := range tiers {
-		if t.Id == nil {
-			continue
+	if _, err := c.GetDatabase(name); err == nil {
+		return nil, errors.Errorf("database %s already exists", name)
+	}
+	if resourceGroup != defaultResourceGroup {
+		if _, err := c.GetResourceGroup(resourceGroup); err != nil {
+			return nil, err
 		}
-		if *t.Id == targetTier {
-			tier = ptr.Ptr(t)
+	}
+
+	database := &apigen_mgmtv2.Database{
+		Name:          name,
+		ResourceGroup: resourceGroup,
+	}
+	c.AddDatabase(database)
+
+	return database, nil
+}
+
+func (acc *FakeCloudClient) DeleteDatabase(ctx context.Context, nsID uuid.UUID, name string) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	c.DeleteDatabase(name)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetSecret(ctx context.Context, nsID uuid.UUID, database, name string) (*apigen_mgmtv2.Secret, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetSecret(database, name)
+}
+
+func (acc *FakeCloudClient) CreateSecret(ctx context.Context, nsID uuid.UUID, database, name, value string) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	if _, err := c.GetSecret(database, name); err == nil {
+		return errors.Errorf("secret %s already exists in database %s", name, database)
+	}
+	if len(value) == 0 {
+		return errors.New("secret value cannot be empty")
+	}
+
+	c.AddSecret(database, &apigen_mgmtv2.Secret{
+		Name:   name,
+		Owner:  "root",
+		Schema: "public",
+	})
+	return nil
+}
+
+func (acc *FakeCloudClient) DeleteSecret(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	c.DeleteSecret(database, name)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetSecretReferences(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.GetSecret(database, name); err != nil {
+		return nil, err
+	}
+	// references are not simulated, so nothing references a secret.
+	return nil, nil
+}
+
+// createMatViewPattern matches the statement the materialized view resource issues.
+var createMatViewPattern = regexp.MustCompile(`(?is)^CREATE MATERIALIZED VIEW "((?:[^"]|"")+)"\."((?:[^"]|"")+)" AS (.+)$`)
+
+// createSourcePattern and createSinkPattern match the statements the source and sink resources
+// issue.
+var (
+	createSourcePattern = regexp.MustCompile(`(?is)^CREATE SOURCE "((?:[^"]|"")+)"\."((?:[^"]|"")+)" .*?WITH \((.+)$`)
+	createSinkPattern   = regexp.MustCompile(`(?is)^CREATE SINK "((?:[^"]|"")+)"\."((?:[^"]|"")+)" FROM .+? WITH \((.+)$`)
+)
+
+// connectorOptionPattern matches the `key = 'value'` options of a WITH clause. The options
+// referencing a secret are left out, as the platform does not report their value either.
+var connectorOptionPattern = regexp.MustCompile(`([A-Za-z0-9_][A-Za-z0-9_.\-]*) = '((?:[^']|'')*)'`)
+
+// connectorOptions returns the options of the WITH clause at the start of clauses.
+func connectorOptions(clauses string) map[string]string {
+	// the options end at the first closing parenthesis out of a literal.
+	inLiteral := false
+	for i, r := range clauses {
+		if r == '\'' {
+			inLiteral = !inLiteral
+		}
+		if r == ')' && !inLiteral {
+			clauses = clauses[:i]
 			break
 		}
 	}
-	if tier == nil {
-		return nil, errors.Errorf("tier %s not found", targetTier)
-	}
-	switch component {
-	case cloudsdk.ComponentCompute:
-		return tier.AvailableComputeNodes, nil
-	case cloudsdk.ComponentCompactor:
-		return tier.AvailableCompactorNodes, nil
-	case cloudsdk.ComponentFrontend:
-		return tier.AvailableFrontendNodes, nil
-	case cloudsdk.ComponentMeta:
-		return tier.AvailableMetaNodes, nil
-	case cloudsdk.ComponentStandalone:
-		return tier.AvailableStandaloneNodes, nil
+	options := map[string]string{}
+	for _, m := range connectorOptionPattern.FindAllStringSubmatch(clauses, -1) {
+		options[m[1]] = strings.ReplaceAll(m[2], `''`, `'`)
 	}
-	return nil, errors.Errorf("component %s not found", component)
+	return options
 }
 
-func (acc *FakeCloudClient) DeleteClusterByNsIDAwait(ctx context.Context, nsID uuid.UUID) error {
+// ExecuteSQLBatch does not interpret the statements, every statement succeeds. The materialized
+// views, sources and sinks created by their resources are recorded so that they can be read back.
+func (acc *FakeCloudClient) ExecuteSQLBatch(ctx context.Context, nsID uuid.UUID, database string, queries []string) ([]apigen_mgmtv2.BatchQueryResult, error) {
 	debugFuncCaller()
 
 	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
-		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
-			return nil
+		return nil, err
+	}
+	var results []apigen_mgmtv2.BatchQueryResult
+	for _, query := range queries {
+		if m := createMatViewPattern.FindStringSubmatch(query); m != nil {
+			c.AddMatView(&apigen_mgmtv2.MatView{
+				DatabaseName:  database,
+				MatViewSchema: strings.ReplaceAll(m[1], `""`, `"`),
+				MatViewName:   strings.ReplaceAll(m[2], `""`, `"`),
+				MatViewOwner:  "root",
+				Definition:    query,
+			})
+		}
+		if m := createSourcePattern.FindStringSubmatch(query); m != nil {
+			properties := connectorOptions(m[3])
+			connectorType := properties["connector"]
+			c.AddSource(database, &apigen_mgmtv2.SourceInfo{
+				Schema:        strings.ReplaceAll(m[1], `""`, `"`),
+				SourceName:    strings.ReplaceAll(m[2], `""`, `"`),
+				Owner:         "root",
+				Definition:    query,
+				ConnectorType: &connectorType,
+				Properties:    properties,
+			})
 		}
+		if m := createSinkPattern.FindStringSubmatch(query); m != nil {
+			properties := connectorOptions(m[3])
+			c.AddSink(database, &apigen_mgmtv2.SinkInfo{
+				Schema:        strings.ReplaceAll(m[1], `""`, `"`),
+				SinkName:      strings.ReplaceAll(m[2], `""`, `"`),
+				Owner:         "root",
+				Definition:    query,
+				ConnectorType: properties["connector"],
+				Properties:    &properties,
+			})
+		}
+		results = append(results, apigen_mgmtv2.BatchQueryResult{
+			Query:  query,
+			Result: &apigen_mgmtv2.QueryResult{},
+		})
+	}
+	return results, nil
+}
+
+// QuerySQL does not interpret the query, it always returns a single row.
+func (acc *FakeCloudClient) QuerySQL(ctx context.Context, nsID uuid.UUID, database, query string) (*apigen_mgmtv2.QueryResult, error) {
+	debugFuncCaller()
+
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
 	}
+	return &apigen_mgmtv2.QueryResult{
+		CommandTag: "SELECT 1",
+		Header:     []apigen_mgmtv2.QueryColumn{{Name: "?column?", DataType: "integer"}},
+		Rows:       []apigen_mgmtv2.QueryRow{{1}},
+	}, nil
+}
 
-	state.GetRegionState(c.tenant.Region).DeleteCluster(nsID)
+func (acc *FakeCloudClient) GetMatView(ctx context.Context, nsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.MatView, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	mv, err := c.GetMatView(database, name)
+	if err != nil {
+		return nil, err
+	}
+	if mv.MatViewSchema != schema {
+		return nil, errors.Wrapf(cloudsdk.ErrMatViewNotFound, "materialized view: %s.%s.%s", database, schema, name)
+	}
+	return mv, nil
+}
+
+// WaitMatViewBackfilled returns immediately, there is no data to backfill.
+func (acc *FakeCloudClient) WaitMatViewBackfilled(ctx context.Context, nsID uuid.UUID, database, name string, timeout time.Duration) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	_, err = c.GetMatView(database, name)
+	return err
+}
+
+func (acc *FakeCloudClient) GetMatViewDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.GetMatView(database, name); err != nil {
+		return nil, err
+	}
+	// dependencies between objects are not simulated.
+	return nil, nil
+}
+
+func (acc *FakeCloudClient) DeleteMatView(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	debugFuncCaller()
 
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return err
+	}
+	c.DeleteMatView(database, name)
 	return nil
 }
 
-func (acc *FakeCloudClient) UpdateClusterImageByNsIDAwait(ctx context.Context, nsID uuid.UUID, version string) error {
+func (acc *FakeCloudClient) GetSource(ctx context.Context, nsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SourceInfo, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	source, err := c.GetSource(database, name)
+	if err != nil {
+		return nil, err
+	}
+	if source.Schema != schema {
+		return nil, errors.Wrapf(cloudsdk.ErrSourceNotFound, "source: %s.%s.%s", database, schema, name)
+	}
+	return source, nil
+}
+
+func (acc *FakeCloudClient) GetSourceDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(nsID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.GetSource(database, name); err != nil {
+		return nil, err
+	}
+	// dependencies between objects are not simulated.
+	return nil, nil
+}
+
+func (acc *FakeCloudClient) DeleteSource(ctx context.Context, nsID uuid.UUID, database, name string) error {
 	debugFuncCaller()
 
-	cluster, err := state.GetClusterByNsID(nsID)
+	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
 		return err
 	}
-	cluster.GetTenant().ImageTag = version
-	r := state.GetRegionState(cluster.GetTenant().Region)
-
-	r.ReplaceCluster(nsID, cluster)
+	c.DeleteSource(database, name)
 	return nil
 }
 
-func (acc *FakeCloudClient) UpdateClusterResourcesByNsIDAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantResourcesRequestBody) error {
+func (acc *FakeCloudClient) GetSink(ctx context.Context, nsID uuid.UUID, database, schema, name string) (*apigen_mgmtv2.SinkInfo, error) {
 	debugFuncCaller()
 
-	cluster, err := state.GetClusterByNsID(nsID)
+	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cluster.GetTenant().Resources.Components.Compactor = componentReqToComponent(req.Compactor)
-	cluster.GetTenant().Resources.Components.Compute = componentReqToComponent(req.Compute)
-	cluster.GetTenant().Resources.Components.Frontend = componentReqToComponent(req.Frontend)
-	cluster.GetTenant().Resources.Components.Meta = componentReqToComponent(req.Meta)
-	cluster.GetTenant().Resources.Components.Standalone = componentReqToComponent(req.Standalone)
-	r := state.GetRegionState(cluster.GetTenant().Region)
-
-	r.ReplaceCluster(nsID, cluster)
-	return nil
+	sink, err := c.GetSink(database, name)
+	if err != nil {
+		return nil, err
+	}
+	if sink.Schema != schema {
+		return nil, errors.Wrapf(cloudsdk.ErrSinkNotFound, "sink: %s.%s.%s", database, schema, name)
+	}
+	return sink, nil
 }
 
-func (acc *FakeCloudClient) UpdateRisingWaveConfigByNsIDAwait(ctx context.Context, nsID uuid.UUID, rwConfig string) error {
+func (acc *FakeCloudClient) GetSinkDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
 	debugFuncCaller()
 
-	cluster, err := state.GetClusterByNsID(nsID)
+	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	cluster.GetTenant().RwConfig = rwConfig
-	r := state.GetRegionState(cluster.GetTenant().Region)
-	r.ReplaceCluster(nsID, cluster)
-	return nil
+	if _, err := c.GetSink(database, name); err != nil {
+		return nil, err
+	}
+	// dependencies between objects are not simulated.
+	return nil, nil
 }
 
-func (acc *FakeCloudClient) GetClusterUser(ctx context.Context, nsID uuid.UUID, username string) (*apigen_mgmtv2.DBUser, error) {
+func (acc *FakeCloudClient) DeleteSink(ctx context.Context, nsID uuid.UUID, database, name string) error {
 	debugFuncCaller()
 
 	c, err := state.GetClusterByNsID(nsID)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	c.DeleteSink(database, name)
+	return nil
+}
 
-	return c.GetClusterUser(username)
+func (acc *FakeCloudClient) PingSource(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostSourcesPingRequestBody) error {
+	debugFuncCaller()
+
+	// every upstream is reachable from a fake cluster.
+	_, err := state.GetClusterByNsID(nsID)
+	return err
 }
 
-func (acc *FakeCloudClient) CreateClusterUser(ctx context.Context, nsID uuid.UUID, username, password string, createDB, superUser, createUser bool) (*apigen_mgmtv2.DBUser, error) {
+func (acc *FakeCloudClient) FetchKafkaSchema(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig, topic string) ([]apigen_mgmtv2.ColumnDesc, error) {
 	debugFuncCaller()
 
-	c, err := state.GetClusterByNsID(nsID)
-	if err != nil {
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
 		return nil, err
 	}
+	return nil, nil
+}
 
-	dbuser := &apigen_mgmtv2.DBUser{
-		Usecreatedb:   createDB,
-		Username:      username,
-		Usesysid:      uint64((time.Now().Unix() << 10) + int64(rand.Int31n(1024))),
-		Usesuper:      superUser,
-		Usecreateuser: createUser,
-		// the platform creates users with CREATE USER, which implies LOGIN.
-		Canlogin: true,
+func (acc *FakeCloudClient) FetchKafkaTopics(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig) ([]string, error) {
+	debugFuncCaller()
+
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
 	}
+	return nil, nil
+}
 
-	c.AddClusterUser(dbuser)
+func (acc *FakeCloudClient) FetchPostgresTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.PostgresCdcConfig) ([]string, error) {
+	debugFuncCaller()
 
-	return dbuser, nil
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
+	}
+	return nil, nil
 }
 
-func (acc *FakeCloudClient) UpdateClusterUserPassword(ctx context.Context, nsID uuid.UUID, username, password string) error {
+func (acc *FakeCloudClient) FetchMysqlTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MysqlCdcConfig) ([]string, error) {
 	debugFuncCaller()
 
-	c, err := state.GetClusterByNsID(nsID)
-	if err != nil {
-		return err
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
 	}
-
-	_, err = c.GetClusterUser(username)
-	return err
+	return nil, nil
 }
 
-func (acc *FakeCloudClient) DeleteClusterUser(ctx context.Context, nsID uuid.UUID, username string) error {
+func (acc *FakeCloudClient) FetchSqlserverTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.SqlserverCdcConfig) ([]string, error) {
 	debugFuncCaller()
 
-	c, err := state.GetClusterByNsID(nsID)
-	if err != nil {
-		return err
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
 	}
+	return nil, nil
+}
 
-	c.DeleteClusterUser(username)
+func (acc *FakeCloudClient) FetchMongodbCollections(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MongodbCdcConfig) ([]string, error) {
+	debugFuncCaller()
 
-	return nil
+	if _, err := state.GetClusterByNsID(nsID); err != nil {
+		return nil, err
+	}
+	return nil, nil
 }
 
 func reqResouceToClusterResource(reqResource *apigen_mgmtv2.TenantResourceRequest) apigen_mgmtv2.TenantResource {
@@ -463,6 +1334,10 @@ func (acc *FakeCloudClient) GetPrivateLinkByName(ctx context.Context, connection
 func (acc *FakeCloudClient) GetBYOCCluster(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
 	debugFuncCaller()
 
+	if cluster, ok := state.GetRegionState(region).GetBYOCCluster(name); ok {
+		return cluster, nil
+	}
+	// BYOC clusters not created through the fake exist too, so that clusters can reference them.
 	return &apigen_mgmtv2.ManagedCluster{
 		Id:   101,
 		Name: name,
@@ -472,6 +1347,114 @@ func (acc *FakeCloudClient) GetBYOCCluster(ctx context.Context, region string, n
 	}, nil
 }
 
+func (acc *FakeCloudClient) CreateBYOCCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByocClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	debugFuncCaller()
+
+	settings := map[string]string{
+		"uuid": uuid.Must(uuid.NewRandom()).String(),
+	}
+	for k, v := range req.Settings {
+		settings[k] = v
+	}
+	cluster := &apigen_mgmtv2.ManagedCluster{
+		Id:       101,
+		Name:     req.Name,
+		Settings: settings,
+		Status:   apigen_mgmtv2.ClusterStatusReady,
+	}
+	state.GetRegionState(region).AddBYOCCluster(cluster)
+	return cluster, nil
+}
+
+func (acc *FakeCloudClient) WaitBYOCClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	debugFuncCaller()
+
+	cluster, ok := state.GetRegionState(region).GetBYOCCluster(name)
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	return cluster, nil
+}
+
+func (acc *FakeCloudClient) UpdateBYOCCluster(ctx context.Context, region string, name string, req apigen_mgmtv2.PutByocClusterRequestBody) error {
+	debugFuncCaller()
+
+	cluster, ok := state.GetRegionState(region).GetBYOCCluster(name)
+	if !ok {
+		return errors.Wrapf(cloudsdk.ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	state.GetRegionState(region).AddBYOCCluster(&apigen_mgmtv2.ManagedCluster{
+		Id:       cluster.Id,
+		Name:     cluster.Name,
+		Settings: req.Settings,
+		Status:   cluster.Status,
+	})
+	return nil
+}
+
+func (acc *FakeCloudClient) UpdateBYOCClusterVersionAwait(ctx context.Context, region string, name, version string) error {
+	debugFuncCaller()
+
+	// the version is not reported back, so there is nothing to record.
+	if _, ok := state.GetRegionState(region).GetBYOCCluster(name); !ok {
+		return errors.Wrapf(cloudsdk.ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	return nil
+}
+
+func (acc *FakeCloudClient) DeleteBYOCClusterAwait(ctx context.Context, region string, name string) error {
+	debugFuncCaller()
+
+	state.GetRegionState(region).DeleteBYOCCluster(name)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetBYOKCluster(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	debugFuncCaller()
+
+	cluster, ok := state.GetRegionState(region).GetBYOKCluster(name)
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrBYOKClusterNotFound, "BYOK cluster %s not found", name)
+	}
+	return cluster, nil
+}
+
+func (acc *FakeCloudClient) CreateBYOKCluster(ctx context.Context, region string, req apigen_mgmtv2.PostByokClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	debugFuncCaller()
+
+	cluster := &apigen_mgmtv2.ManagedCluster{
+		Id:   102,
+		Name: req.Name,
+		Settings: map[string]string{
+			"uuid": uuid.Must(uuid.NewRandom()).String(),
+		},
+		Status: apigen_mgmtv2.ClusterStatusReady,
+	}
+	state.GetRegionState(region).AddBYOKCluster(cluster)
+	return cluster, nil
+}
+
+func (acc *FakeCloudClient) WaitBYOKClusterReady(ctx context.Context, region string, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	debugFuncCaller()
+
+	return acc.GetBYOKCluster(ctx, region, name)
+}
+
+func (acc *FakeCloudClient) UpdateBYOKClusterVersionAwait(ctx context.Context, region string, name, version string) error {
+	debugFuncCaller()
+
+	// the version is not reported back, so there is nothing to record.
+	_, err := acc.GetBYOKCluster(ctx, region, name)
+	return err
+}
+
+func (acc *FakeCloudClient) DeleteBYOKClusterAwait(ctx context.Context, region string, name string) error {
+	debugFuncCaller()
+
+	state.GetRegionState(region).DeleteBYOKCluster(name)
+	return nil
+}
+
 func (acc *FakeCloudClient) GetResourceGroup(ctx context.Context, clusterNsID uuid.UUID, name string) (*apigen_mgmtv2.ResourceGroupDetails, error) {
 	debugFuncCaller()
 
@@ -606,3 +1589,234 @@ func (acc *FakeCloudClient) RemoveAllowedIamRoleAwait(ctx context.Context, clust
 	c.RemoveAllowedIamRole(roleArn)
 	return nil
 }
+
+func (acc *FakeCloudClient) GetBackupSnapshot(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(clusterNsID)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetBackupSnapshot(snapshotID)
+}
+
+func (acc *FakeCloudClient) CreateBackupSnapshot(ctx context.Context, clusterNsID uuid.UUID) (uuid.UUID, error) {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(clusterNsID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	tenant := c.GetTenant()
+	snapshot := &apigen_mgmtv2.BackupSnapshotItem{
+		Id:           uuid.Must(uuid.NewRandom()),
+		CreatedAt:    time.Now().UTC(),
+		CreatedBy:    "terraform",
+		RwSnapshotId: rand.Intn(1 << 20),
+		RwVersion:    tenant.ImageTag,
+		Status:       cloudsdk.BackupSnapshotStatusCompleted,
+	}
+	c.AddBackupSnapshot(snapshot)
+	return snapshot.Id, nil
+}
+
+func (acc *FakeCloudClient) WaitBackupSnapshotCompleted(ctx context.Context, clusterNsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	debugFuncCaller()
+
+	// snapshots are taken synchronously in the fake backend.
+	return acc.GetBackupSnapshot(ctx, clusterNsID, snapshotID)
+}
+
+func (acc *FakeCloudClient) DeleteBackupSnapshotAwait(ctx context.Context, clusterNsID, snapshotID uuid.UUID) error {
+	debugFuncCaller()
+
+	c, err := state.GetClusterByNsID(clusterNsID)
+	if err != nil {
+		return err
+	}
+	c.DeleteBackupSnapshot(snapshotID)
+	return nil
+}
+
+// roles are the built-in roles of an organization.
+var roles = []apigen_accv2.Role{
+	{Id: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Name: "OrganizationAdmin", Description: "Full access to the organization."},
+	{Id: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Name: "ProjectAdmin", Description: "Full access to the clusters of the organization."},
+	{Id: uuid.MustParse("00000000-0000-0000-0000-000000000003"), Name: "ProjectViewer", Description: "Read-only access to the clusters of the organization."},
+}
+
+// orgID is the organization of the API key.
+var orgID = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+
+// principalTypeServiceAccount is the principal type of the role bindings of service accounts.
+const principalTypeServiceAccount = "ServiceAccount"
+
+func (acc *FakeCloudClient) GetServiceAccount(ctx context.Context, id uuid.UUID) (*apigen_accv2.ServiceAccount, error) {
+	debugFuncCaller()
+
+	return state.GetServiceAccount(id)
+}
+
+func (acc *FakeCloudClient) CreateServiceAccount(ctx context.Context, name, description string, roleID uuid.UUID) (*apigen_accv2.ServiceAccount, error) {
+	debugFuncCaller()
+
+	var roleName string
+	for _, role := range roles {
+		if role.Id == roleID {
+			roleName = role.Name
+		}
+	}
+	if roleName == "" {
+		return nil, errors.Errorf("expected status code 200 but got 400, message: role %s not found", roleID)
+	}
+
+	now := time.Now()
+	sa := &apigen_accv2.ServiceAccount{
+		Id:          uuid.Must(uuid.NewRandom()),
+		OrgId:       orgID,
+		Name:        name,
+		Description: description,
+		Roles:       []string{roleName},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	state.AddServiceAccount(sa)
+	state.SetRoleBindings(sa.Id, []apigen_accv2.RoleBinding{{
+		Principal:     sa.Id,
+		PrincipalType: ptr.Ptr(principalTypeServiceAccount),
+		RoleId:        roleID,
+		RoleName:      roleName,
+	}})
+	return sa, nil
+}
+
+func (acc *FakeCloudClient) UpdateServiceAccountDescription(ctx context.Context, id uuid.UUID, description string) error {
+	debugFuncCaller()
+
+	sa, err := state.GetServiceAccount(id)
+	if err != nil {
+		return err
+	}
+	sa.Description = description
+	sa.UpdatedAt = time.Now()
+	return nil
+}
+
+func (acc *FakeCloudClient) DeleteServiceAccount(ctx context.Context, id uuid.UUID) error {
+	debugFuncCaller()
+
+	state.DeleteServiceAccount(id)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetAPIKey(ctx context.Context, id uint64) (*apigen_accv2.ApiKey, error) {
+	debugFuncCaller()
+
+	return state.GetAPIKey(id)
+}
+
+func (acc *FakeCloudClient) CreateAPIKey(ctx context.Context, principal uuid.UUID, description string) (*apigen_accv2.CreatedApiKey, error) {
+	debugFuncCaller()
+
+	now := time.Now()
+	key := &apigen_accv2.ApiKey{
+		Key:         strings.ReplaceAll(uuid.NewString(), "-", "")[:16],
+		Principal:   principal,
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := state.AddAPIKey(key); err != nil {
+		return nil, err
+	}
+	return &apigen_accv2.CreatedApiKey{
+		Id:          key.Id,
+		Key:         key.Key,
+		Secret:      strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Principal:   key.Principal,
+		Description: key.Description,
+		CreatedAt:   key.CreatedAt,
+		UpdatedAt:   key.UpdatedAt,
+	}, nil
+}
+
+func (acc *FakeCloudClient) UpdateAPIKeyDescription(ctx context.Context, id uint64, description string) (*apigen_accv2.ApiKey, error) {
+	debugFuncCaller()
+
+	key, err := state.GetAPIKey(id)
+	if err != nil {
+		return nil, err
+	}
+	key.Description = description
+	key.UpdatedAt = time.Now()
+	return key, nil
+}
+
+func (acc *FakeCloudClient) DeleteAPIKey(ctx context.Context, id uint64) error {
+	debugFuncCaller()
+
+	state.DeleteAPIKey(id)
+	return nil
+}
+
+func (acc *FakeCloudClient) GetRoles(ctx context.Context) ([]apigen_accv2.Role, error) {
+	debugFuncCaller()
+
+	return append([]apigen_accv2.Role{}, roles...), nil
+}
+
+func (acc *FakeCloudClient) GetRoleBindings(ctx context.Context, principal *uuid.UUID) ([]apigen_accv2.RoleBinding, error) {
+	debugFuncCaller()
+
+	return state.GetRoleBindings(principal), nil
+}
+
+func (acc *FakeCloudClient) UpdateRoleBindings(ctx context.Context, principal uuid.UUID, principalType string, roleIDs []uuid.UUID) error {
+	debugFuncCaller()
+
+	var bindings []apigen_accv2.RoleBinding
+	for _, roleID := range roleIDs {
+		var roleName string
+		for _, role := range roles {
+			if role.Id == roleID {
+				roleName = role.Name
+			}
+		}
+		if roleName == "" {
+			return errors.Errorf("invalid role bindings of %s %s: role %s not found", principalType, principal, roleID)
+		}
+		bindings = append(bindings, apigen_accv2.RoleBinding{
+			Principal:     principal,
+			PrincipalType: ptr.Ptr(principalType),
+			RoleId:        roleID,
+			RoleName:      roleName,
+		})
+	}
+	state.SetRoleBindings(principal, bindings)
+	return nil
+}
+
+func (acc *FakeCloudClient) AddRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error {
+	debugFuncCaller()
+
+	roleIDs := []uuid.UUID{}
+	for _, b := range state.GetRoleBindings(&principal) {
+		if b.RoleId == roleID {
+			return nil
+		}
+		roleIDs = append(roleIDs, b.RoleId)
+	}
+	return acc.UpdateRoleBindings(ctx, principal, principalType, append(roleIDs, roleID))
+}
+
+func (acc *FakeCloudClient) RemoveRoleBinding(ctx context.Context, principal uuid.UUID, principalType string, roleID uuid.UUID) error {
+	debugFuncCaller()
+
+	roleIDs := []uuid.UUID{}
+	for _, b := range state.GetRoleBindings(&principal) {
+		if b.RoleId != roleID {
+			roleIDs = append(roleIDs, b.RoleId)
+		}
+	}
+	return acc.UpdateRoleBindings(ctx, principal, principalType, roleIDs)
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
 )
 
 type ClusterState struct {
@@ -18,6 +20,21 @@ type ClusterState struct {
 	// username -> user
 	users map[string]*apigen_mgmtv2.DBUser
 
+	// database name -> database
+	databases map[string]*apigen_mgmtv2.Database
+
+	// database name -> secret name -> secret, the values are never read back so they are not kept
+	secrets map[string]map[string]*apigen_mgmtv2.Secret
+
+	// database name -> materialized view name -> materialized view
+	matViews map[string]map[string]*apigen_mgmtv2.MatView
+
+	// database name -> source name -> source
+	sources map[string]map[string]*apigen_mgmtv2.SourceInfo
+
+	// database name -> sink name -> sink
+	sinks map[string]map[string]*apigen_mgmtv2.SinkInfo
+
 	// private link ID -> private link
 	privateLinks map[string]*apigen_mgmtv2.PrivateLink
 
@@ -26,15 +43,41 @@ type ClusterState struct {
 
 	// IAM role ARNs allowed to assume a role into the customer's account
 	allowedIamRoles map[string]bool
+
+	// snapshot ID -> backup snapshot
+	backupSnapshots map[string]*apigen_mgmtv2.BackupSnapshotItem
+
+	maintenanceWindow apigen_mgmtv2.MaintenanceWindow
+
+	// the version of the serverless compaction extension, whether it is enabled is part of the
+	// tenant
+	serverlessCompactionVersion string
+
+	// nil if the iceberg compaction extension is not enabled
+	icebergCompaction *apigen_mgmtv2.IcebergCompaction
+
+	// nil if the serverless backfill extension is not enabled
+	serverlessBackfill *apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody
+
+	// the size of the compute cache is part of the tenant
+	computeCachePerformanceTier apigen_mgmtv2.ComputeCachePerformanceTier
 }
 
 func NewClusterState(tenant *apigen_mgmtv2.Tenant) *ClusterState {
 	return &ClusterState{
 		tenant:          tenant,
 		users:           map[string]*apigen_mgmtv2.DBUser{},
+		databases:       map[string]*apigen_mgmtv2.Database{},
+		secrets:         map[string]map[string]*apigen_mgmtv2.Secret{},
+		matViews:        map[string]map[string]*apigen_mgmtv2.MatView{},
+		sources:         map[string]map[string]*apigen_mgmtv2.SourceInfo{},
+		sinks:           map[string]map[string]*apigen_mgmtv2.SinkInfo{},
 		privateLinks:    map[string]*apigen_mgmtv2.PrivateLink{},
 		resourceGroups:  map[string]*apigen_mgmtv2.ResourceGroupDetails{},
 		allowedIamRoles: map[string]bool{},
+		backupSnapshots: map[string]*apigen_mgmtv2.BackupSnapshotItem{},
+
+		computeCachePerformanceTier: apigen_mgmtv2.ComputeCachePerformanceTierStandard,
 	}
 }
 
@@ -70,6 +113,143 @@ func (c *ClusterState) GetClusterUser(username string) (*apigen_mgmtv2.DBUser, e
 	return u, nil
 }
 
+func (c *ClusterState) AddDatabase(database *apigen_mgmtv2.Database) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.databases[database.Name] = database
+}
+
+func (c *ClusterState) DeleteDatabase(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.databases, name)
+}
+
+func (c *ClusterState) GetDatabase(name string) (*apigen_mgmtv2.Database, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	d, ok := c.databases[name]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrDatabaseNotFound, "database: %s", name)
+	}
+	return d, nil
+}
+
+func (c *ClusterState) AddSecret(database string, secret *apigen_mgmtv2.Secret) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.secrets[database]; !ok {
+		c.secrets[database] = map[string]*apigen_mgmtv2.Secret{}
+	}
+	c.secrets[database][secret.Name] = secret
+}
+
+func (c *ClusterState) DeleteSecret(database, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.secrets[database], name)
+}
+
+func (c *ClusterState) GetSecret(database, name string) (*apigen_mgmtv2.Secret, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, ok := c.secrets[database][name]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrSecretNotFound, "secret: %s.%s", database, name)
+	}
+	return s, nil
+}
+
+func (c *ClusterState) AddMatView(matView *apigen_mgmtv2.MatView) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.matViews[matView.DatabaseName]; !ok {
+		c.matViews[matView.DatabaseName] = map[string]*apigen_mgmtv2.MatView{}
+	}
+	c.matViews[matView.DatabaseName][matView.MatViewName] = matView
+}
+
+func (c *ClusterState) DeleteMatView(database, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.matViews[database], name)
+}
+
+func (c *ClusterState) GetMatView(database, name string) (*apigen_mgmtv2.MatView, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	mv, ok := c.matViews[database][name]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrMatViewNotFound, "materialized view: %s.%s", database, name)
+	}
+	return mv, nil
+}
+
+func (c *ClusterState) AddSource(database string, source *apigen_mgmtv2.SourceInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.sources[database]; !ok {
+		c.sources[database] = map[string]*apigen_mgmtv2.SourceInfo{}
+	}
+	c.sources[database][source.SourceName] = source
+}
+
+func (c *ClusterState) DeleteSource(database, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sources[database], name)
+}
+
+func (c *ClusterState) GetSource(database, name string) (*apigen_mgmtv2.SourceInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	source, ok := c.sources[database][name]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrSourceNotFound, "source: %s.%s", database, name)
+	}
+	return source, nil
+}
+
+func (c *ClusterState) AddSink(database string, sink *apigen_mgmtv2.SinkInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.sinks[database]; !ok {
+		c.sinks[database] = map[string]*apigen_mgmtv2.SinkInfo{}
+	}
+	c.sinks[database][sink.SinkName] = sink
+}
+
+func (c *ClusterState) DeleteSink(database, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sinks[database], name)
+}
+
+func (c *ClusterState) GetSink(database, name string) (*apigen_mgmtv2.SinkInfo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	sink, ok := c.sinks[database][name]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrSinkNotFound, "sink: %s.%s", database, name)
+	}
+	return sink, nil
+}
+
 func (c *ClusterState) GetTenant() *apigen_mgmtv2.Tenant {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -77,6 +257,13 @@ func (c *ClusterState) GetTenant() *apigen_mgmtv2.Tenant {
 	return c.tenant
 }
 
+func (c *ClusterState) SetStatus(status apigen_mgmtv2.TenantStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tenant.Status = status
+}
+
 func (c *ClusterState) AddPrivateLink(privateLink *apigen_mgmtv2.PrivateLink) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -139,13 +326,17 @@ func (c *ClusterState) DeleteResourceGroup(name string) {
 }
 
 type RegionState struct {
-	clusters map[string]*ClusterState
-	mu       sync.RWMutex
+	clusters     map[string]*ClusterState
+	byocClusters map[string]*apigen_mgmtv2.ManagedCluster
+	byokClusters map[string]*apigen_mgmtv2.ManagedCluster
+	mu           sync.RWMutex
 }
 
 func NewRegionState() *RegionState {
 	return &RegionState{
-		clusters: map[string]*ClusterState{},
+		clusters:     map[string]*ClusterState{},
+		byocClusters: map[string]*apigen_mgmtv2.ManagedCluster{},
+		byokClusters: map[string]*apigen_mgmtv2.ManagedCluster{},
 	}
 }
 
@@ -189,8 +380,64 @@ func (s *RegionState) ReplaceCluster(nsID uuid.UUID, cluster *ClusterState) {
 	s.clusters[nsID.String()] = cluster
 }
 
+func (r *RegionState) GetBYOCCluster(name string) (*apigen_mgmtv2.ManagedCluster, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cluster, ok := r.byocClusters[name]
+	return cluster, ok
+}
+
+func (r *RegionState) AddBYOCCluster(cluster *apigen_mgmtv2.ManagedCluster) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byocClusters[cluster.Name] = cluster
+}
+
+func (r *RegionState) DeleteBYOCCluster(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.byocClusters, name)
+}
+
+func (r *RegionState) GetBYOKCluster(name string) (*apigen_mgmtv2.ManagedCluster, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cluster, ok := r.byokClusters[name]
+	return cluster, ok
+}
+
+func (r *RegionState) AddBYOKCluster(cluster *apigen_mgmtv2.ManagedCluster) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byokClusters[cluster.Name] = cluster
+}
+
+func (r *RegionState) DeleteBYOKCluster(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.byokClusters, name)
+}
+
 type GlobalState struct {
 	regionStates map[string]*RegionState
+
+	// service account ID -> service account
+	serviceAccounts map[string]*apigen_accv2.ServiceAccount
+
+	// API key ID -> API key, the secrets are never read back so they are not kept
+	apiKeys   map[uint64]*apigen_accv2.ApiKey
+	apiKeySeq uint64
+
+	// principal -> role bindings of the principal
+	roleBindings map[string][]apigen_accv2.RoleBinding
+
+	mu sync.RWMutex
 }
 
 func (g *GlobalState) GetRegionState(region string) *RegionState {
@@ -224,7 +471,10 @@ var state GlobalState
 
 func init() {
 	state = GlobalState{
-		regionStates: map[string]*RegionState{},
+		regionStates:    map[string]*RegionState{},
+		serviceAccounts: map[string]*apigen_accv2.ServiceAccount{},
+		apiKeys:         map[uint64]*apigen_accv2.ApiKey{},
+		roleBindings:    map[string][]apigen_accv2.RoleBinding{},
 	}
 }
 
@@ -232,6 +482,108 @@ func GetFakerState() *GlobalState {
 	return &state
 }
 
+func (g *GlobalState) GetServiceAccount(id uuid.UUID) (*apigen_accv2.ServiceAccount, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	sa, ok := g.serviceAccounts[id.String()]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrServiceAccountNotFound, "service account %s", id)
+	}
+	return sa, nil
+}
+
+func (g *GlobalState) AddServiceAccount(sa *apigen_accv2.ServiceAccount) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.serviceAccounts[sa.Id.String()] = sa
+}
+
+func (g *GlobalState) DeleteServiceAccount(id uuid.UUID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.serviceAccounts, id.String())
+	delete(g.roleBindings, id.String())
+}
+
+func (g *GlobalState) GetAPIKey(id uint64) (*apigen_accv2.ApiKey, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	key, ok := g.apiKeys[id]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrAPIKeyNotFound, "API key %d", id)
+	}
+	return key, nil
+}
+
+// AddAPIKey assigns the next ID to the API key and counts it in its service account.
+func (g *GlobalState) AddAPIKey(key *apigen_accv2.ApiKey) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	sa, ok := g.serviceAccounts[key.Principal.String()]
+	if !ok {
+		return errors.Wrapf(cloudsdk.ErrServiceAccountNotFound, "service account %s", key.Principal)
+	}
+	g.apiKeySeq++
+	key.Id = g.apiKeySeq
+	g.apiKeys[key.Id] = key
+	sa.ApiKeyCount++
+	return nil
+}
+
+func (g *GlobalState) DeleteAPIKey(id uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	key, ok := g.apiKeys[id]
+	if !ok {
+		return
+	}
+	delete(g.apiKeys, id)
+	if sa, ok := g.serviceAccounts[key.Principal.String()]; ok {
+		sa.ApiKeyCount--
+	}
+}
+
+// GetRoleBindings returns the role bindings of the organization, or only those of the principal
+// if it is not nil.
+func (g *GlobalState) GetRoleBindings(principal *uuid.UUID) []apigen_accv2.RoleBinding {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if principal != nil {
+		return append([]apigen_accv2.RoleBinding{}, g.roleBindings[principal.String()]...)
+	}
+	rtn := []apigen_accv2.RoleBinding{}
+	for _, bindings := range g.roleBindings {
+		rtn = append(rtn, bindings...)
+	}
+	return rtn
+}
+
+// SetRoleBindings replaces the role bindings of the principal, and the roles of the service
+// account if the principal is one.
+func (g *GlobalState) SetRoleBindings(principal uuid.UUID, bindings []apigen_accv2.RoleBinding) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(bindings) == 0 {
+		delete(g.roleBindings, principal.String())
+	} else {
+		g.roleBindings[principal.String()] = bindings
+	}
+	if sa, ok := g.serviceAccounts[principal.String()]; ok {
+		sa.Roles = []string{}
+		for _, b := range bindings {
+			sa.Roles = append(sa.Roles, b.RoleName)
+		}
+	}
+}
+
 func (c *ClusterState) GetAllowedIamRoles() []string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -257,3 +609,132 @@ func (c *ClusterState) RemoveAllowedIamRole(roleArn string) {
 
 	delete(c.allowedIamRoles, roleArn)
 }
+
+func (c *ClusterState) GetBackupSnapshot(id uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, ok := c.backupSnapshots[id.String()]
+	if !ok {
+		return nil, errors.Wrapf(cloudsdk.ErrBackupSnapshotNotFound, "id: %s", id.String())
+	}
+	return s, nil
+}
+
+func (c *ClusterState) AddBackupSnapshot(snapshot *apigen_mgmtv2.BackupSnapshotItem) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.backupSnapshots[snapshot.Id.String()] = snapshot
+}
+
+func (c *ClusterState) DeleteBackupSnapshot(id uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.backupSnapshots, id.String())
+}
+
+func (c *ClusterState) GetMaintenanceWindow() apigen_mgmtv2.MaintenanceWindow {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.maintenanceWindow
+}
+
+func (c *ClusterState) SetMaintenanceWindow(window apigen_mgmtv2.MaintenanceWindow) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maintenanceWindow = window
+}
+
+func (c *ClusterState) SetServerlessCompaction(req *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if req == nil {
+		c.tenant.Extensions = nil
+		c.serverlessCompactionVersion = ""
+		return
+	}
+	c.tenant.Extensions = &apigen_mgmtv2.TenantExtensions{
+		ServerlessCompaction: &apigen_mgmtv2.TenantExtensionServerlessCompaction{
+			Enabled:                      true,
+			MaximumCompactionConcurrency: req.MaximumCompactionConcurrency,
+		},
+	}
+	if req.Version != nil {
+		c.serverlessCompactionVersion = *req.Version
+	} else if c.serverlessCompactionVersion == "" {
+		c.serverlessCompactionVersion = c.tenant.ImageTag
+	}
+}
+
+func (c *ClusterState) GetServerlessCompaction() *apigen_mgmtv2.GetTenantExtensionCompactionResponseBody {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tenant.Extensions == nil || c.tenant.Extensions.ServerlessCompaction == nil {
+		return &apigen_mgmtv2.GetTenantExtensionCompactionResponseBody{Status: "Disabled"}
+	}
+	return &apigen_mgmtv2.GetTenantExtensionCompactionResponseBody{
+		Status:                       "Running",
+		MaximumCompactionConcurrency: ptr.Ptr(c.tenant.Extensions.ServerlessCompaction.MaximumCompactionConcurrency),
+		Version:                      ptr.Ptr(c.serverlessCompactionVersion),
+	}
+}
+
+func (c *ClusterState) GetIcebergCompaction() (*apigen_mgmtv2.IcebergCompaction, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.icebergCompaction == nil {
+		return nil, errors.Wrapf(cloudsdk.ErrIcebergCompactionNotFound, "cluster %s", c.tenant.NsId)
+	}
+	ret := *c.icebergCompaction
+	return &ret, nil
+}
+
+func (c *ClusterState) SetIcebergCompaction(compaction *apigen_mgmtv2.IcebergCompaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.icebergCompaction = compaction
+}
+
+func (c *ClusterState) GetServerlessBackfill() (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.serverlessBackfill == nil {
+		return nil, errors.Wrapf(cloudsdk.ErrServerlessBackfillNotFound, "cluster %s", c.tenant.NsId)
+	}
+	ret := *c.serverlessBackfill
+	return &ret, nil
+}
+
+func (c *ClusterState) SetServerlessBackfill(backfill *apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.serverlessBackfill = backfill
+}
+
+func (c *ClusterState) GetComputeCache() apigen_mgmtv2.TenantComputeCacheConfig {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return apigen_mgmtv2.TenantComputeCacheConfig{
+		SizeGb:          c.tenant.Resources.ComputeCache.SizeGb,
+		PerformanceTier: ptr.Ptr(c.computeCachePerformanceTier),
+	}
+}
+
+func (c *ClusterState) SetComputeCache(sizeGb int, tier apigen_mgmtv2.ComputeCachePerformanceTier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tenant.Resources.ComputeCache.SizeGb = sizeGb
+	c.computeCachePerformanceTier = tier
+}
@@ -7,12 +7,14 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	cloudsdk "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
-	apigen "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
-	apigen0 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	apigen "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	apigen0 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
+	apigen1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
 )
 
 // MockCloudClientInterface is a mock of CloudClientInterface interface.
@@ -52,11 +54,85 @@ func (mr *MockCloudClientInterfaceMockRecorder) AddAllowedIamRoleAwait(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAllowedIamRoleAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).AddAllowedIamRoleAwait), arg0, arg1, arg2)
 }
 
+// AddRoleBinding mocks base method.
+func (m *MockCloudClientInterface) AddRoleBinding(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoleBinding", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRoleBinding indicates an expected call of AddRoleBinding.
+func (mr *MockCloudClientInterfaceMockRecorder) AddRoleBinding(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleBinding", reflect.TypeOf((*MockCloudClientInterface)(nil).AddRoleBinding), arg0, arg1, arg2, arg3)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockCloudClientInterface) CreateAPIKey(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen.CreatedApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen.CreatedApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateAPIKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateAPIKey), arg0, arg1, arg2)
+}
+
+// CreateBYOCCluster mocks base method.
+func (m *MockCloudClientInterface) CreateBYOCCluster(arg0 context.Context, arg1 string, arg2 apigen1.PostByocClustersRequestBody) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBYOCCluster", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBYOCCluster indicates an expected call of CreateBYOCCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateBYOCCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBYOCCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateBYOCCluster), arg0, arg1, arg2)
+}
+
+// CreateBYOKCluster mocks base method.
+func (m *MockCloudClientInterface) CreateBYOKCluster(arg0 context.Context, arg1 string, arg2 apigen1.PostByokClustersRequestBody) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBYOKCluster", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBYOKCluster indicates an expected call of CreateBYOKCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateBYOKCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBYOKCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateBYOKCluster), arg0, arg1, arg2)
+}
+
+// CreateBackupSnapshot mocks base method.
+func (m *MockCloudClientInterface) CreateBackupSnapshot(arg0 context.Context, arg1 uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupSnapshot indicates an expected call of CreateBackupSnapshot.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateBackupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupSnapshot", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateBackupSnapshot), arg0, arg1)
+}
+
 // CreateClusterAwait mocks base method.
-func (m *MockCloudClientInterface) CreateClusterAwait(arg0 context.Context, arg1 string, arg2 apigen0.TenantRequestRequestBody) (*apigen0.Tenant, error) {
+func (m *MockCloudClientInterface) CreateClusterAwait(arg0 context.Context, arg1 string, arg2 apigen1.TenantRequestRequestBody) (*apigen1.Tenant, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateClusterAwait", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.Tenant)
+	ret0, _ := ret[0].(*apigen1.Tenant)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -67,11 +143,41 @@ func (mr *MockCloudClientInterfaceMockRecorder) CreateClusterAwait(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateClusterAwait), arg0, arg1, arg2)
 }
 
+// CreateClusterAwaitingConfig mocks base method.
+func (m *MockCloudClientInterface) CreateClusterAwaitingConfig(arg0 context.Context, arg1 string, arg2 apigen1.TenantRequestRequestBody) (*apigen1.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterAwaitingConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterAwaitingConfig indicates an expected call of CreateClusterAwaitingConfig.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateClusterAwaitingConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterAwaitingConfig", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateClusterAwaitingConfig), arg0, arg1, arg2)
+}
+
+// CreateClusterOAuthToken mocks base method.
+func (m *MockCloudClientInterface) CreateClusterOAuthToken(arg0 context.Context, arg1 uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterOAuthToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterOAuthToken indicates an expected call of CreateClusterOAuthToken.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateClusterOAuthToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterOAuthToken", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateClusterOAuthToken), arg0, arg1)
+}
+
 // CreateClusterUser mocks base method.
-func (m *MockCloudClientInterface) CreateClusterUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string, arg4, arg5, arg6 bool) (*apigen0.DBUser, error) {
+func (m *MockCloudClientInterface) CreateClusterUser(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string, arg4, arg5, arg6 bool) (*apigen1.DBUser, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateClusterUser", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
-	ret0, _ := ret[0].(*apigen0.DBUser)
+	ret0, _ := ret[0].(*apigen1.DBUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -82,8 +188,23 @@ func (mr *MockCloudClientInterfaceMockRecorder) CreateClusterUser(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterUser", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateClusterUser), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// CreateDatabase mocks base method.
+func (m *MockCloudClientInterface) CreateDatabase(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (*apigen1.Database, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDatabase", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apigen1.Database)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDatabase indicates an expected call of CreateDatabase.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateDatabase(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDatabase", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateDatabase), arg0, arg1, arg2, arg3)
+}
+
 // CreatePrivateLinkAwait mocks base method.
-func (m *MockCloudClientInterface) CreatePrivateLinkAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen0.PostPrivateLinkRequestBody) (*cloudsdk.PrivateLinkInfo, error) {
+func (m *MockCloudClientInterface) CreatePrivateLinkAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostPrivateLinkRequestBody) (*cloudsdk.PrivateLinkInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePrivateLinkAwait", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*cloudsdk.PrivateLinkInfo)
@@ -98,10 +219,10 @@ func (mr *MockCloudClientInterfaceMockRecorder) CreatePrivateLinkAwait(arg0, arg
 }
 
 // CreateResourceGroupAwait mocks base method.
-func (m *MockCloudClientInterface) CreateResourceGroupAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen0.CreateResourceGroupsRequestBody) (*apigen0.ResourceGroupDetails, error) {
+func (m *MockCloudClientInterface) CreateResourceGroupAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.CreateResourceGroupsRequestBody) (*apigen1.ResourceGroupDetails, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateResourceGroupAwait", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.ResourceGroupDetails)
+	ret0, _ := ret[0].(*apigen1.ResourceGroupDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -112,6 +233,91 @@ func (mr *MockCloudClientInterfaceMockRecorder) CreateResourceGroupAwait(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceGroupAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateResourceGroupAwait), arg0, arg1, arg2)
 }
 
+// CreateSecret mocks base method.
+func (m *MockCloudClientInterface) CreateSecret(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecret indicates an expected call of CreateSecret.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateSecret(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateSecret), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockCloudClientInterface) CreateServiceAccount(arg0 context.Context, arg1, arg2 string, arg3 uuid.UUID) (*apigen.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apigen.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockCloudClientInterfaceMockRecorder) CreateServiceAccount(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockCloudClientInterface)(nil).CreateServiceAccount), arg0, arg1, arg2, arg3)
+}
+
+// DeleteAPIKey mocks base method.
+func (m *MockCloudClientInterface) DeleteAPIKey(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteAPIKey), arg0, arg1)
+}
+
+// DeleteBYOCClusterAwait mocks base method.
+func (m *MockCloudClientInterface) DeleteBYOCClusterAwait(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBYOCClusterAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBYOCClusterAwait indicates an expected call of DeleteBYOCClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteBYOCClusterAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBYOCClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteBYOCClusterAwait), arg0, arg1, arg2)
+}
+
+// DeleteBYOKClusterAwait mocks base method.
+func (m *MockCloudClientInterface) DeleteBYOKClusterAwait(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBYOKClusterAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBYOKClusterAwait indicates an expected call of DeleteBYOKClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteBYOKClusterAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBYOKClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteBYOKClusterAwait), arg0, arg1, arg2)
+}
+
+// DeleteBackupSnapshotAwait mocks base method.
+func (m *MockCloudClientInterface) DeleteBackupSnapshotAwait(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupSnapshotAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBackupSnapshotAwait indicates an expected call of DeleteBackupSnapshotAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteBackupSnapshotAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupSnapshotAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteBackupSnapshotAwait), arg0, arg1, arg2)
+}
+
 // DeleteClusterByNsIDAwait mocks base method.
 func (m *MockCloudClientInterface) DeleteClusterByNsIDAwait(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -140,6 +346,34 @@ func (mr *MockCloudClientInterfaceMockRecorder) DeleteClusterUser(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterUser", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteClusterUser), arg0, arg1, arg2)
 }
 
+// DeleteDatabase mocks base method.
+func (m *MockCloudClientInterface) DeleteDatabase(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabase", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabase indicates an expected call of DeleteDatabase.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteDatabase(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabase", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteDatabase), arg0, arg1, arg2)
+}
+
+// DeleteMatView mocks base method.
+func (m *MockCloudClientInterface) DeleteMatView(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMatView", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMatView indicates an expected call of DeleteMatView.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteMatView(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMatView", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteMatView), arg0, arg1, arg2, arg3)
+}
+
 // DeletePrivateLinkAwait mocks base method.
 func (m *MockCloudClientInterface) DeletePrivateLinkAwait(arg0 context.Context, arg1, arg2 uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -168,205 +402,1026 @@ func (mr *MockCloudClientInterfaceMockRecorder) DeleteResourceGroupAwait(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceGroupAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteResourceGroupAwait), arg0, arg1, arg2)
 }
 
-// GetAllowedIamRoles mocks base method.
-func (m *MockCloudClientInterface) GetAllowedIamRoles(arg0 context.Context, arg1 uuid.UUID) ([]string, error) {
+// DeleteSecret mocks base method.
+func (m *MockCloudClientInterface) DeleteSecret(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllowedIamRoles", arg0, arg1)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAllowedIamRoles indicates an expected call of GetAllowedIamRoles.
-func (mr *MockCloudClientInterfaceMockRecorder) GetAllowedIamRoles(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteSecret(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllowedIamRoles", reflect.TypeOf((*MockCloudClientInterface)(nil).GetAllowedIamRoles), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteSecret), arg0, arg1, arg2, arg3)
 }
 
-// GetAvailableComponentTypes mocks base method.
-func (m *MockCloudClientInterface) GetAvailableComponentTypes(arg0 context.Context, arg1 string, arg2 apigen.TierId, arg3 string) ([]apigen.AvailableComponentType, error) {
+// DeleteServiceAccount mocks base method.
+func (m *MockCloudClientInterface) DeleteServiceAccount(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableComponentTypes", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]apigen.AvailableComponentType)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAvailableComponentTypes indicates an expected call of GetAvailableComponentTypes.
-func (mr *MockCloudClientInterfaceMockRecorder) GetAvailableComponentTypes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// DeleteServiceAccount indicates an expected call of DeleteServiceAccount.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteServiceAccount(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableComponentTypes", reflect.TypeOf((*MockCloudClientInterface)(nil).GetAvailableComponentTypes), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccount", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteServiceAccount), arg0, arg1)
 }
 
-// GetBYOCCluster mocks base method.
-func (m *MockCloudClientInterface) GetBYOCCluster(arg0 context.Context, arg1, arg2 string) (*apigen0.ManagedCluster, error) {
+// DeleteSink mocks base method.
+func (m *MockCloudClientInterface) DeleteSink(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBYOCCluster", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.ManagedCluster)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteSink", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetBYOCCluster indicates an expected call of GetBYOCCluster.
-func (mr *MockCloudClientInterfaceMockRecorder) GetBYOCCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DeleteSink indicates an expected call of DeleteSink.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteSink(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBYOCCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).GetBYOCCluster), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSink", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteSink), arg0, arg1, arg2, arg3)
 }
 
-// GetClusterByNsID mocks base method.
-func (m *MockCloudClientInterface) GetClusterByNsID(arg0 context.Context, arg1 uuid.UUID) (*apigen0.Tenant, error) {
+// DeleteSource mocks base method.
+func (m *MockCloudClientInterface) DeleteSource(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterByNsID", arg0, arg1)
-	ret0, _ := ret[0].(*apigen0.Tenant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteSource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetClusterByNsID indicates an expected call of GetClusterByNsID.
-func (mr *MockCloudClientInterfaceMockRecorder) GetClusterByNsID(arg0, arg1 interface{}) *gomock.Call {
+// DeleteSource indicates an expected call of DeleteSource.
+func (mr *MockCloudClientInterfaceMockRecorder) DeleteSource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByNsID", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterByNsID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSource", reflect.TypeOf((*MockCloudClientInterface)(nil).DeleteSource), arg0, arg1, arg2, arg3)
 }
 
-// GetClusterByRegionAndName mocks base method.
-func (m *MockCloudClientInterface) GetClusterByRegionAndName(arg0 context.Context, arg1, arg2 string) (*apigen0.Tenant, error) {
+// DisableIcebergCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) DisableIcebergCompactionAwait(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterByRegionAndName", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.Tenant)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DisableIcebergCompactionAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetClusterByRegionAndName indicates an expected call of GetClusterByRegionAndName.
-func (mr *MockCloudClientInterfaceMockRecorder) GetClusterByRegionAndName(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DisableIcebergCompactionAwait indicates an expected call of DisableIcebergCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DisableIcebergCompactionAwait(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByRegionAndName", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterByRegionAndName), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableIcebergCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DisableIcebergCompactionAwait), arg0, arg1)
 }
 
-// GetClusterUser mocks base method.
-func (m *MockCloudClientInterface) GetClusterUser(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen0.DBUser, error) {
+// DisableServerlessBackfillAwait mocks base method.
+func (m *MockCloudClientInterface) DisableServerlessBackfillAwait(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterUser", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.DBUser)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DisableServerlessBackfillAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetClusterUser indicates an expected call of GetClusterUser.
-func (mr *MockCloudClientInterfaceMockRecorder) GetClusterUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DisableServerlessBackfillAwait indicates an expected call of DisableServerlessBackfillAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DisableServerlessBackfillAwait(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterUser", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterUser), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableServerlessBackfillAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DisableServerlessBackfillAwait), arg0, arg1)
 }
 
-// GetPrivateLink mocks base method.
-func (m *MockCloudClientInterface) GetPrivateLink(arg0 context.Context, arg1 uuid.UUID) (*cloudsdk.PrivateLinkInfo, error) {
+// DisableServerlessCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) DisableServerlessCompactionAwait(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateLink", arg0, arg1)
-	ret0, _ := ret[0].(*cloudsdk.PrivateLinkInfo)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DisableServerlessCompactionAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPrivateLink indicates an expected call of GetPrivateLink.
-func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLink(arg0, arg1 interface{}) *gomock.Call {
+// DisableServerlessCompactionAwait indicates an expected call of DisableServerlessCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) DisableServerlessCompactionAwait(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLink", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLink), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableServerlessCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).DisableServerlessCompactionAwait), arg0, arg1)
 }
 
-// GetPrivateLinkByName mocks base method.
-func (m *MockCloudClientInterface) GetPrivateLinkByName(arg0 context.Context, arg1 string) (*cloudsdk.PrivateLinkInfo, error) {
+// EnableIcebergCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) EnableIcebergCompactionAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody, arg3 func()) (*apigen1.IcebergCompaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateLinkByName", arg0, arg1)
-	ret0, _ := ret[0].(*cloudsdk.PrivateLinkInfo)
+	ret := m.ctrl.Call(m, "EnableIcebergCompactionAwait", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apigen1.IcebergCompaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPrivateLinkByName indicates an expected call of GetPrivateLinkByName.
-func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLinkByName(arg0, arg1 interface{}) *gomock.Call {
+// EnableIcebergCompactionAwait indicates an expected call of EnableIcebergCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) EnableIcebergCompactionAwait(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLinkByName", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLinkByName), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableIcebergCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).EnableIcebergCompactionAwait), arg0, arg1, arg2, arg3)
 }
 
-// GetPrivateLinks mocks base method.
-func (m *MockCloudClientInterface) GetPrivateLinks(arg0 context.Context) ([]cloudsdk.PrivateLinkInfo, error) {
+// EnableServerlessBackfillAwait mocks base method.
+func (m *MockCloudClientInterface) EnableServerlessBackfillAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.TenantExtensionServerlessBackfillRequest, arg3 func()) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPrivateLinks", arg0)
-	ret0, _ := ret[0].([]cloudsdk.PrivateLinkInfo)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EnableServerlessBackfillAwait", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPrivateLinks indicates an expected call of GetPrivateLinks.
-func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLinks(arg0 interface{}) *gomock.Call {
+// EnableServerlessBackfillAwait indicates an expected call of EnableServerlessBackfillAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) EnableServerlessBackfillAwait(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLinks", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLinks), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableServerlessBackfillAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).EnableServerlessBackfillAwait), arg0, arg1, arg2, arg3)
 }
 
-// GetResourceGroup mocks base method.
-func (m *MockCloudClientInterface) GetResourceGroup(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen0.ResourceGroupDetails, error) {
+// EnableServerlessCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) EnableServerlessCompactionAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.TenantExtensionServerlessCompactionRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResourceGroup", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*apigen0.ResourceGroupDetails)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EnableServerlessCompactionAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetResourceGroup indicates an expected call of GetResourceGroup.
-func (mr *MockCloudClientInterfaceMockRecorder) GetResourceGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+// EnableServerlessCompactionAwait indicates an expected call of EnableServerlessCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) EnableServerlessCompactionAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroup", reflect.TypeOf((*MockCloudClientInterface)(nil).GetResourceGroup), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableServerlessCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).EnableServerlessCompactionAwait), arg0, arg1, arg2)
 }
 
-// GetTiers mocks base method.
-func (m *MockCloudClientInterface) GetTiers(arg0 context.Context, arg1 string) ([]apigen.Tier, error) {
+// ExecuteSQLBatch mocks base method.
+func (m *MockCloudClientInterface) ExecuteSQLBatch(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 []string) ([]apigen1.BatchQueryResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTiers", arg0, arg1)
-	ret0, _ := ret[0].([]apigen.Tier)
+	ret := m.ctrl.Call(m, "ExecuteSQLBatch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.BatchQueryResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTiers indicates an expected call of GetTiers.
-func (mr *MockCloudClientInterfaceMockRecorder) GetTiers(arg0, arg1 interface{}) *gomock.Call {
+// ExecuteSQLBatch indicates an expected call of ExecuteSQLBatch.
+func (mr *MockCloudClientInterfaceMockRecorder) ExecuteSQLBatch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTiers", reflect.TypeOf((*MockCloudClientInterface)(nil).GetTiers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteSQLBatch", reflect.TypeOf((*MockCloudClientInterface)(nil).ExecuteSQLBatch), arg0, arg1, arg2, arg3)
 }
 
-// Ping mocks base method.
-func (m *MockCloudClientInterface) Ping(arg0 context.Context) error {
+// FetchKafkaSchema mocks base method.
+func (m *MockCloudClientInterface) FetchKafkaSchema(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.KafkaConfig, arg3 string) ([]apigen1.ColumnDesc, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ping", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FetchKafkaSchema", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.ColumnDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Ping indicates an expected call of Ping.
-func (mr *MockCloudClientInterfaceMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+// FetchKafkaSchema indicates an expected call of FetchKafkaSchema.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchKafkaSchema(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCloudClientInterface)(nil).Ping), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKafkaSchema", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchKafkaSchema), arg0, arg1, arg2, arg3)
 }
 
-// RemoveAllowedIamRoleAwait mocks base method.
-func (m *MockCloudClientInterface) RemoveAllowedIamRoleAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+// FetchKafkaTopics mocks base method.
+func (m *MockCloudClientInterface) FetchKafkaTopics(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.KafkaConfig) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAllowedIamRoleAwait", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FetchKafkaTopics", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveAllowedIamRoleAwait indicates an expected call of RemoveAllowedIamRoleAwait.
-func (mr *MockCloudClientInterfaceMockRecorder) RemoveAllowedIamRoleAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FetchKafkaTopics indicates an expected call of FetchKafkaTopics.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchKafkaTopics(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllowedIamRoleAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).RemoveAllowedIamRoleAwait), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchKafkaTopics", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchKafkaTopics), arg0, arg1, arg2)
 }
 
-// UpdateClusterImageByNsIDAwait mocks base method.
-func (m *MockCloudClientInterface) UpdateClusterImageByNsIDAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+// FetchMongodbCollections mocks base method.
+func (m *MockCloudClientInterface) FetchMongodbCollections(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.MongodbCdcConfig) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateClusterImageByNsIDAwait", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "FetchMongodbCollections", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMongodbCollections indicates an expected call of FetchMongodbCollections.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchMongodbCollections(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMongodbCollections", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchMongodbCollections), arg0, arg1, arg2)
+}
+
+// FetchMysqlTables mocks base method.
+func (m *MockCloudClientInterface) FetchMysqlTables(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.MysqlCdcConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchMysqlTables", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMysqlTables indicates an expected call of FetchMysqlTables.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchMysqlTables(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMysqlTables", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchMysqlTables), arg0, arg1, arg2)
+}
+
+// FetchPostgresTables mocks base method.
+func (m *MockCloudClientInterface) FetchPostgresTables(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostgresCdcConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPostgresTables", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPostgresTables indicates an expected call of FetchPostgresTables.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchPostgresTables(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPostgresTables", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchPostgresTables), arg0, arg1, arg2)
+}
+
+// FetchSqlserverTables mocks base method.
+func (m *MockCloudClientInterface) FetchSqlserverTables(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.SqlserverCdcConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSqlserverTables", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSqlserverTables indicates an expected call of FetchSqlserverTables.
+func (mr *MockCloudClientInterfaceMockRecorder) FetchSqlserverTables(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSqlserverTables", reflect.TypeOf((*MockCloudClientInterface)(nil).FetchSqlserverTables), arg0, arg1, arg2)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockCloudClientInterface) GetAPIKey(arg0 context.Context, arg1 uint64) (*apigen.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*apigen.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockCloudClientInterfaceMockRecorder) GetAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockCloudClientInterface)(nil).GetAPIKey), arg0, arg1)
+}
+
+// GetAllowedIamRoles mocks base method.
+func (m *MockCloudClientInterface) GetAllowedIamRoles(arg0 context.Context, arg1 uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllowedIamRoles", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllowedIamRoles indicates an expected call of GetAllowedIamRoles.
+func (mr *MockCloudClientInterfaceMockRecorder) GetAllowedIamRoles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllowedIamRoles", reflect.TypeOf((*MockCloudClientInterface)(nil).GetAllowedIamRoles), arg0, arg1)
+}
+
+// GetAvailableComponentTypes mocks base method.
+func (m *MockCloudClientInterface) GetAvailableComponentTypes(arg0 context.Context, arg1 string, arg2 apigen0.TierId, arg3 string) ([]apigen0.AvailableComponentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableComponentTypes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen0.AvailableComponentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableComponentTypes indicates an expected call of GetAvailableComponentTypes.
+func (mr *MockCloudClientInterfaceMockRecorder) GetAvailableComponentTypes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableComponentTypes", reflect.TypeOf((*MockCloudClientInterface)(nil).GetAvailableComponentTypes), arg0, arg1, arg2, arg3)
+}
+
+// GetBYOCCluster mocks base method.
+func (m *MockCloudClientInterface) GetBYOCCluster(arg0 context.Context, arg1, arg2 string) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBYOCCluster", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBYOCCluster indicates an expected call of GetBYOCCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) GetBYOCCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBYOCCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).GetBYOCCluster), arg0, arg1, arg2)
+}
+
+// GetBYOKCluster mocks base method.
+func (m *MockCloudClientInterface) GetBYOKCluster(arg0 context.Context, arg1, arg2 string) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBYOKCluster", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBYOKCluster indicates an expected call of GetBYOKCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) GetBYOKCluster(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBYOKCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).GetBYOKCluster), arg0, arg1, arg2)
+}
+
+// GetBackupSnapshot mocks base method.
+func (m *MockCloudClientInterface) GetBackupSnapshot(arg0 context.Context, arg1, arg2 uuid.UUID) (*apigen1.BackupSnapshotItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackupSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.BackupSnapshotItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackupSnapshot indicates an expected call of GetBackupSnapshot.
+func (mr *MockCloudClientInterfaceMockRecorder) GetBackupSnapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupSnapshot", reflect.TypeOf((*MockCloudClientInterface)(nil).GetBackupSnapshot), arg0, arg1, arg2)
+}
+
+// GetClusterByNsID mocks base method.
+func (m *MockCloudClientInterface) GetClusterByNsID(arg0 context.Context, arg1 uuid.UUID) (*apigen1.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterByNsID", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterByNsID indicates an expected call of GetClusterByNsID.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterByNsID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByNsID", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterByNsID), arg0, arg1)
+}
+
+// GetClusterByRegionAndName mocks base method.
+func (m *MockCloudClientInterface) GetClusterByRegionAndName(arg0 context.Context, arg1, arg2 string) (*apigen1.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterByRegionAndName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterByRegionAndName indicates an expected call of GetClusterByRegionAndName.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterByRegionAndName(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByRegionAndName", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterByRegionAndName), arg0, arg1, arg2)
+}
+
+// GetClusterCACertificate mocks base method.
+func (m *MockCloudClientInterface) GetClusterCACertificate(arg0 context.Context, arg1 uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterCACertificate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterCACertificate indicates an expected call of GetClusterCACertificate.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterCACertificate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterCACertificate", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterCACertificate), arg0, arg1)
+}
+
+// GetClusterCloudMetadata mocks base method.
+func (m *MockCloudClientInterface) GetClusterCloudMetadata(arg0 context.Context, arg1 uuid.UUID) (*apigen1.TenantCloudMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterCloudMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.TenantCloudMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterCloudMetadata indicates an expected call of GetClusterCloudMetadata.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterCloudMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterCloudMetadata", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterCloudMetadata), arg0, arg1)
+}
+
+// GetClusterEndpoint mocks base method.
+func (m *MockCloudClientInterface) GetClusterEndpoint(arg0 context.Context, arg1 uuid.UUID) (*apigen1.Endpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.Endpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterEndpoint indicates an expected call of GetClusterEndpoint.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterEndpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterEndpoint", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterEndpoint), arg0, arg1)
+}
+
+// GetClusterMaintenanceWindow mocks base method.
+func (m *MockCloudClientInterface) GetClusterMaintenanceWindow(arg0 context.Context, arg1 uuid.UUID) (*apigen1.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterMaintenanceWindow", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterMaintenanceWindow indicates an expected call of GetClusterMaintenanceWindow.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterMaintenanceWindow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMaintenanceWindow", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterMaintenanceWindow), arg0, arg1)
+}
+
+// GetClusterUser mocks base method.
+func (m *MockCloudClientInterface) GetClusterUser(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen1.DBUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.DBUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterUser indicates an expected call of GetClusterUser.
+func (mr *MockCloudClientInterfaceMockRecorder) GetClusterUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterUser", reflect.TypeOf((*MockCloudClientInterface)(nil).GetClusterUser), arg0, arg1, arg2)
+}
+
+// GetComputeCache mocks base method.
+func (m *MockCloudClientInterface) GetComputeCache(arg0 context.Context, arg1 uuid.UUID) (*apigen1.GetTenantComputeCacheResponseBody, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputeCache", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.GetTenantComputeCacheResponseBody)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputeCache indicates an expected call of GetComputeCache.
+func (mr *MockCloudClientInterfaceMockRecorder) GetComputeCache(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputeCache", reflect.TypeOf((*MockCloudClientInterface)(nil).GetComputeCache), arg0, arg1)
+}
+
+// GetComputeCacheCapabilities mocks base method.
+func (m *MockCloudClientInterface) GetComputeCacheCapabilities(arg0 context.Context, arg1 uuid.UUID) (*apigen1.GetTenantComputeCacheCapabilitiesResponseBody, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComputeCacheCapabilities", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.GetTenantComputeCacheCapabilitiesResponseBody)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComputeCacheCapabilities indicates an expected call of GetComputeCacheCapabilities.
+func (mr *MockCloudClientInterfaceMockRecorder) GetComputeCacheCapabilities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComputeCacheCapabilities", reflect.TypeOf((*MockCloudClientInterface)(nil).GetComputeCacheCapabilities), arg0, arg1)
+}
+
+// GetDatabase mocks base method.
+func (m *MockCloudClientInterface) GetDatabase(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen1.Database, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabase", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.Database)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabase indicates an expected call of GetDatabase.
+func (mr *MockCloudClientInterfaceMockRecorder) GetDatabase(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockCloudClientInterface)(nil).GetDatabase), arg0, arg1, arg2)
+}
+
+// GetIcebergCompaction mocks base method.
+func (m *MockCloudClientInterface) GetIcebergCompaction(arg0 context.Context, arg1 uuid.UUID) (*apigen1.IcebergCompaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIcebergCompaction", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.IcebergCompaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIcebergCompaction indicates an expected call of GetIcebergCompaction.
+func (mr *MockCloudClientInterfaceMockRecorder) GetIcebergCompaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIcebergCompaction", reflect.TypeOf((*MockCloudClientInterface)(nil).GetIcebergCompaction), arg0, arg1)
+}
+
+// GetMatView mocks base method.
+func (m *MockCloudClientInterface) GetMatView(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) (*apigen1.MatView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatView", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*apigen1.MatView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatView indicates an expected call of GetMatView.
+func (mr *MockCloudClientInterfaceMockRecorder) GetMatView(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatView", reflect.TypeOf((*MockCloudClientInterface)(nil).GetMatView), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetMatViewDownstreams mocks base method.
+func (m *MockCloudClientInterface) GetMatViewDownstreams(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]apigen1.RwDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatViewDownstreams", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.RwDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatViewDownstreams indicates an expected call of GetMatViewDownstreams.
+func (mr *MockCloudClientInterfaceMockRecorder) GetMatViewDownstreams(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatViewDownstreams", reflect.TypeOf((*MockCloudClientInterface)(nil).GetMatViewDownstreams), arg0, arg1, arg2, arg3)
+}
+
+// GetPrivateLink mocks base method.
+func (m *MockCloudClientInterface) GetPrivateLink(arg0 context.Context, arg1 uuid.UUID) (*cloudsdk.PrivateLinkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateLink", arg0, arg1)
+	ret0, _ := ret[0].(*cloudsdk.PrivateLinkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivateLink indicates an expected call of GetPrivateLink.
+func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLink(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLink", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLink), arg0, arg1)
+}
+
+// GetPrivateLinkByName mocks base method.
+func (m *MockCloudClientInterface) GetPrivateLinkByName(arg0 context.Context, arg1 string) (*cloudsdk.PrivateLinkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateLinkByName", arg0, arg1)
+	ret0, _ := ret[0].(*cloudsdk.PrivateLinkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivateLinkByName indicates an expected call of GetPrivateLinkByName.
+func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLinkByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLinkByName", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLinkByName), arg0, arg1)
+}
+
+// GetPrivateLinks mocks base method.
+func (m *MockCloudClientInterface) GetPrivateLinks(arg0 context.Context) ([]cloudsdk.PrivateLinkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateLinks", arg0)
+	ret0, _ := ret[0].([]cloudsdk.PrivateLinkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivateLinks indicates an expected call of GetPrivateLinks.
+func (mr *MockCloudClientInterfaceMockRecorder) GetPrivateLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateLinks", reflect.TypeOf((*MockCloudClientInterface)(nil).GetPrivateLinks), arg0)
+}
+
+// GetResourceGroup mocks base method.
+func (m *MockCloudClientInterface) GetResourceGroup(arg0 context.Context, arg1 uuid.UUID, arg2 string) (*apigen1.ResourceGroupDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ResourceGroupDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceGroup indicates an expected call of GetResourceGroup.
+func (mr *MockCloudClientInterfaceMockRecorder) GetResourceGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroup", reflect.TypeOf((*MockCloudClientInterface)(nil).GetResourceGroup), arg0, arg1, arg2)
+}
+
+// GetRoleBindings mocks base method.
+func (m *MockCloudClientInterface) GetRoleBindings(arg0 context.Context, arg1 *uuid.UUID) ([]apigen.RoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleBindings", arg0, arg1)
+	ret0, _ := ret[0].([]apigen.RoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleBindings indicates an expected call of GetRoleBindings.
+func (mr *MockCloudClientInterfaceMockRecorder) GetRoleBindings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleBindings", reflect.TypeOf((*MockCloudClientInterface)(nil).GetRoleBindings), arg0, arg1)
+}
+
+// GetRoles mocks base method.
+func (m *MockCloudClientInterface) GetRoles(arg0 context.Context) ([]apigen.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", arg0)
+	ret0, _ := ret[0].([]apigen.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockCloudClientInterfaceMockRecorder) GetRoles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockCloudClientInterface)(nil).GetRoles), arg0)
+}
+
+// GetRootCACertificate mocks base method.
+func (m *MockCloudClientInterface) GetRootCACertificate(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRootCACertificate", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRootCACertificate indicates an expected call of GetRootCACertificate.
+func (mr *MockCloudClientInterfaceMockRecorder) GetRootCACertificate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRootCACertificate", reflect.TypeOf((*MockCloudClientInterface)(nil).GetRootCACertificate), arg0, arg1)
+}
+
+// GetSecret mocks base method.
+func (m *MockCloudClientInterface) GetSecret(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (*apigen1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apigen1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSecret(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSecret), arg0, arg1, arg2, arg3)
+}
+
+// GetSecretReferences mocks base method.
+func (m *MockCloudClientInterface) GetSecretReferences(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]apigen1.RwDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretReferences", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.RwDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretReferences indicates an expected call of GetSecretReferences.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSecretReferences(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretReferences", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSecretReferences), arg0, arg1, arg2, arg3)
+}
+
+// GetServerlessBackfill mocks base method.
+func (m *MockCloudClientInterface) GetServerlessBackfill(arg0 context.Context, arg1 uuid.UUID) (*apigen1.GetTenantExtensionServerlessBackfillResponseBody, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerlessBackfill", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.GetTenantExtensionServerlessBackfillResponseBody)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerlessBackfill indicates an expected call of GetServerlessBackfill.
+func (mr *MockCloudClientInterfaceMockRecorder) GetServerlessBackfill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerlessBackfill", reflect.TypeOf((*MockCloudClientInterface)(nil).GetServerlessBackfill), arg0, arg1)
+}
+
+// GetServerlessCompaction mocks base method.
+func (m *MockCloudClientInterface) GetServerlessCompaction(arg0 context.Context, arg1 uuid.UUID) (*apigen1.GetTenantExtensionCompactionResponseBody, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServerlessCompaction", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.GetTenantExtensionCompactionResponseBody)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServerlessCompaction indicates an expected call of GetServerlessCompaction.
+func (mr *MockCloudClientInterfaceMockRecorder) GetServerlessCompaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerlessCompaction", reflect.TypeOf((*MockCloudClientInterface)(nil).GetServerlessCompaction), arg0, arg1)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockCloudClientInterface) GetServiceAccount(arg0 context.Context, arg1 uuid.UUID) (*apigen.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", arg0, arg1)
+	ret0, _ := ret[0].(*apigen.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockCloudClientInterfaceMockRecorder) GetServiceAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockCloudClientInterface)(nil).GetServiceAccount), arg0, arg1)
+}
+
+// GetSink mocks base method.
+func (m *MockCloudClientInterface) GetSink(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) (*apigen1.SinkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSink", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*apigen1.SinkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSink indicates an expected call of GetSink.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSink(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSink", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSink), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetSinkDownstreams mocks base method.
+func (m *MockCloudClientInterface) GetSinkDownstreams(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]apigen1.RwDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSinkDownstreams", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.RwDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSinkDownstreams indicates an expected call of GetSinkDownstreams.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSinkDownstreams(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSinkDownstreams", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSinkDownstreams), arg0, arg1, arg2, arg3)
+}
+
+// GetSource mocks base method.
+func (m *MockCloudClientInterface) GetSource(arg0 context.Context, arg1 uuid.UUID, arg2, arg3, arg4 string) (*apigen1.SourceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSource", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*apigen1.SourceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSource indicates an expected call of GetSource.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSource(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSource", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSource), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetSourceDownstreams mocks base method.
+func (m *MockCloudClientInterface) GetSourceDownstreams(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) ([]apigen1.RwDependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSourceDownstreams", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]apigen1.RwDependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSourceDownstreams indicates an expected call of GetSourceDownstreams.
+func (mr *MockCloudClientInterfaceMockRecorder) GetSourceDownstreams(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSourceDownstreams", reflect.TypeOf((*MockCloudClientInterface)(nil).GetSourceDownstreams), arg0, arg1, arg2, arg3)
+}
+
+// GetTiers mocks base method.
+func (m *MockCloudClientInterface) GetTiers(arg0 context.Context, arg1 string) ([]apigen0.Tier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTiers", arg0, arg1)
+	ret0, _ := ret[0].([]apigen0.Tier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTiers indicates an expected call of GetTiers.
+func (mr *MockCloudClientInterfaceMockRecorder) GetTiers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTiers", reflect.TypeOf((*MockCloudClientInterface)(nil).GetTiers), arg0, arg1)
+}
+
+// InPlaceRestoreClusterAwait mocks base method.
+func (m *MockCloudClientInterface) InPlaceRestoreClusterAwait(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InPlaceRestoreClusterAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InPlaceRestoreClusterAwait indicates an expected call of InPlaceRestoreClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) InPlaceRestoreClusterAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InPlaceRestoreClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).InPlaceRestoreClusterAwait), arg0, arg1, arg2)
+}
+
+// Ping mocks base method.
+func (m *MockCloudClientInterface) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockCloudClientInterfaceMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCloudClientInterface)(nil).Ping), arg0)
+}
+
+// PingSource mocks base method.
+func (m *MockCloudClientInterface) PingSource(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostSourcesPingRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PingSource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PingSource indicates an expected call of PingSource.
+func (mr *MockCloudClientInterfaceMockRecorder) PingSource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PingSource", reflect.TypeOf((*MockCloudClientInterface)(nil).PingSource), arg0, arg1, arg2)
+}
+
+// QuerySQL mocks base method.
+func (m *MockCloudClientInterface) QuerySQL(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string) (*apigen1.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySQL", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apigen1.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySQL indicates an expected call of QuerySQL.
+func (mr *MockCloudClientInterfaceMockRecorder) QuerySQL(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySQL", reflect.TypeOf((*MockCloudClientInterface)(nil).QuerySQL), arg0, arg1, arg2, arg3)
+}
+
+// RemoveAllowedIamRoleAwait mocks base method.
+func (m *MockCloudClientInterface) RemoveAllowedIamRoleAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAllowedIamRoleAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAllowedIamRoleAwait indicates an expected call of RemoveAllowedIamRoleAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) RemoveAllowedIamRoleAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAllowedIamRoleAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).RemoveAllowedIamRoleAwait), arg0, arg1, arg2)
+}
+
+// RemoveRoleBinding mocks base method.
+func (m *MockCloudClientInterface) RemoveRoleBinding(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoleBinding", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoleBinding indicates an expected call of RemoveRoleBinding.
+func (mr *MockCloudClientInterfaceMockRecorder) RemoveRoleBinding(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleBinding", reflect.TypeOf((*MockCloudClientInterface)(nil).RemoveRoleBinding), arg0, arg1, arg2, arg3)
+}
+
+// RestartClusterAwait mocks base method.
+func (m *MockCloudClientInterface) RestartClusterAwait(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartClusterAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartClusterAwait indicates an expected call of RestartClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) RestartClusterAwait(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).RestartClusterAwait), arg0, arg1)
+}
+
+// RestoreCluster mocks base method.
+func (m *MockCloudClientInterface) RestoreCluster(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCluster", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCluster indicates an expected call of RestoreCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) RestoreCluster(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).RestoreCluster), arg0, arg1, arg2, arg3)
+}
+
+// StartClusterAwait mocks base method.
+func (m *MockCloudClientInterface) StartClusterAwait(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartClusterAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartClusterAwait indicates an expected call of StartClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) StartClusterAwait(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).StartClusterAwait), arg0, arg1)
+}
+
+// StopClusterAwait mocks base method.
+func (m *MockCloudClientInterface) StopClusterAwait(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopClusterAwait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopClusterAwait indicates an expected call of StopClusterAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) StopClusterAwait(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopClusterAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).StopClusterAwait), arg0, arg1)
+}
+
+// UpdateAPIKeyDescription mocks base method.
+func (m *MockCloudClientInterface) UpdateAPIKeyDescription(arg0 context.Context, arg1 uint64, arg2 string) (*apigen.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAPIKeyDescription", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAPIKeyDescription indicates an expected call of UpdateAPIKeyDescription.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateAPIKeyDescription(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAPIKeyDescription", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateAPIKeyDescription), arg0, arg1, arg2)
+}
+
+// UpdateBYOCCluster mocks base method.
+func (m *MockCloudClientInterface) UpdateBYOCCluster(arg0 context.Context, arg1, arg2 string, arg3 apigen1.PutByocClusterRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBYOCCluster", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBYOCCluster indicates an expected call of UpdateBYOCCluster.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateBYOCCluster(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBYOCCluster", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateBYOCCluster), arg0, arg1, arg2, arg3)
+}
+
+// UpdateBYOCClusterVersionAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateBYOCClusterVersionAwait(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBYOCClusterVersionAwait", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBYOCClusterVersionAwait indicates an expected call of UpdateBYOCClusterVersionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateBYOCClusterVersionAwait(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBYOCClusterVersionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateBYOCClusterVersionAwait), arg0, arg1, arg2, arg3)
+}
+
+// UpdateBYOKClusterVersionAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateBYOKClusterVersionAwait(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBYOKClusterVersionAwait", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBYOKClusterVersionAwait indicates an expected call of UpdateBYOKClusterVersionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateBYOKClusterVersionAwait(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBYOKClusterVersionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateBYOKClusterVersionAwait), arg0, arg1, arg2, arg3)
+}
+
+// UpdateClusterBYOKConfig mocks base method.
+func (m *MockCloudClientInterface) UpdateClusterBYOKConfig(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostBYOKTenantConfigRequestBody) (*apigen1.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterBYOKConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterBYOKConfig indicates an expected call of UpdateClusterBYOKConfig.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateClusterBYOKConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterBYOKConfig", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateClusterBYOKConfig), arg0, arg1, arg2)
+}
+
+// UpdateClusterBYOKConfigAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateClusterBYOKConfigAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostBYOKTenantConfigRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterBYOKConfigAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterBYOKConfigAwait indicates an expected call of UpdateClusterBYOKConfigAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateClusterBYOKConfigAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterBYOKConfigAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateClusterBYOKConfigAwait), arg0, arg1, arg2)
+}
+
+// UpdateClusterImageByNsIDAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateClusterImageByNsIDAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterImageByNsIDAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // UpdateClusterImageByNsIDAwait indicates an expected call of UpdateClusterImageByNsIDAwait.
@@ -375,8 +1430,22 @@ func (mr *MockCloudClientInterfaceMockRecorder) UpdateClusterImageByNsIDAwait(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterImageByNsIDAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateClusterImageByNsIDAwait), arg0, arg1, arg2)
 }
 
+// UpdateClusterMaintenanceWindow mocks base method.
+func (m *MockCloudClientInterface) UpdateClusterMaintenanceWindow(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.MaintenanceWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterMaintenanceWindow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterMaintenanceWindow indicates an expected call of UpdateClusterMaintenanceWindow.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateClusterMaintenanceWindow(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterMaintenanceWindow", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateClusterMaintenanceWindow), arg0, arg1, arg2)
+}
+
 // UpdateClusterResourcesByNsIDAwait mocks base method.
-func (m *MockCloudClientInterface) UpdateClusterResourcesByNsIDAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen0.PostTenantResourcesRequestBody) error {
+func (m *MockCloudClientInterface) UpdateClusterResourcesByNsIDAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostTenantResourcesRequestBody) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateClusterResourcesByNsIDAwait", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -403,11 +1472,40 @@ func (mr *MockCloudClientInterfaceMockRecorder) UpdateClusterUserPassword(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterUserPassword", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateClusterUserPassword), arg0, arg1, arg2, arg3)
 }
 
+// UpdateComputeCacheAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateComputeCacheAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PostTenantComputeCacheRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComputeCacheAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComputeCacheAwait indicates an expected call of UpdateComputeCacheAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateComputeCacheAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComputeCacheAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateComputeCacheAwait), arg0, arg1, arg2)
+}
+
+// UpdateIcebergCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateIcebergCompactionAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen1.IcebergCompaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIcebergCompactionAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.IcebergCompaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIcebergCompactionAwait indicates an expected call of UpdateIcebergCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateIcebergCompactionAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIcebergCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateIcebergCompactionAwait), arg0, arg1, arg2)
+}
+
 // UpdateResourceGroupAwait mocks base method.
-func (m *MockCloudClientInterface) UpdateResourceGroupAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 apigen0.UpdateResourceGroupsRequestBody) (*apigen0.ResourceGroupDetails, error) {
+func (m *MockCloudClientInterface) UpdateResourceGroupAwait(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 apigen1.UpdateResourceGroupsRequestBody) (*apigen1.ResourceGroupDetails, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateResourceGroupAwait", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(*apigen0.ResourceGroupDetails)
+	ret0, _ := ret[0].(*apigen1.ResourceGroupDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -431,3 +1529,133 @@ func (mr *MockCloudClientInterfaceMockRecorder) UpdateRisingWaveConfigByNsIDAwai
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRisingWaveConfigByNsIDAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateRisingWaveConfigByNsIDAwait), arg0, arg1, arg2)
 }
+
+// UpdateRoleBindings mocks base method.
+func (m *MockCloudClientInterface) UpdateRoleBindings(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleBindings", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoleBindings indicates an expected call of UpdateRoleBindings.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateRoleBindings(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleBindings", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateRoleBindings), arg0, arg1, arg2, arg3)
+}
+
+// UpdateServerlessBackfillAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateServerlessBackfillAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.TenantExtensionServerlessBackfillRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerlessBackfillAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerlessBackfillAwait indicates an expected call of UpdateServerlessBackfillAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateServerlessBackfillAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerlessBackfillAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateServerlessBackfillAwait), arg0, arg1, arg2)
+}
+
+// UpdateServerlessCompactionAwait mocks base method.
+func (m *MockCloudClientInterface) UpdateServerlessCompactionAwait(arg0 context.Context, arg1 uuid.UUID, arg2 apigen1.TenantExtensionServerlessCompactionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServerlessCompactionAwait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServerlessCompactionAwait indicates an expected call of UpdateServerlessCompactionAwait.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateServerlessCompactionAwait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServerlessCompactionAwait", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateServerlessCompactionAwait), arg0, arg1, arg2)
+}
+
+// UpdateServiceAccountDescription mocks base method.
+func (m *MockCloudClientInterface) UpdateServiceAccountDescription(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceAccountDescription", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceAccountDescription indicates an expected call of UpdateServiceAccountDescription.
+func (mr *MockCloudClientInterfaceMockRecorder) UpdateServiceAccountDescription(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceAccountDescription", reflect.TypeOf((*MockCloudClientInterface)(nil).UpdateServiceAccountDescription), arg0, arg1, arg2)
+}
+
+// WaitBYOCClusterReady mocks base method.
+func (m *MockCloudClientInterface) WaitBYOCClusterReady(arg0 context.Context, arg1, arg2 string) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitBYOCClusterReady", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitBYOCClusterReady indicates an expected call of WaitBYOCClusterReady.
+func (mr *MockCloudClientInterfaceMockRecorder) WaitBYOCClusterReady(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitBYOCClusterReady", reflect.TypeOf((*MockCloudClientInterface)(nil).WaitBYOCClusterReady), arg0, arg1, arg2)
+}
+
+// WaitBYOKClusterReady mocks base method.
+func (m *MockCloudClientInterface) WaitBYOKClusterReady(arg0 context.Context, arg1, arg2 string) (*apigen1.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitBYOKClusterReady", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitBYOKClusterReady indicates an expected call of WaitBYOKClusterReady.
+func (mr *MockCloudClientInterfaceMockRecorder) WaitBYOKClusterReady(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitBYOKClusterReady", reflect.TypeOf((*MockCloudClientInterface)(nil).WaitBYOKClusterReady), arg0, arg1, arg2)
+}
+
+// WaitBackupSnapshotCompleted mocks base method.
+func (m *MockCloudClientInterface) WaitBackupSnapshotCompleted(arg0 context.Context, arg1, arg2 uuid.UUID) (*apigen1.BackupSnapshotItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitBackupSnapshotCompleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*apigen1.BackupSnapshotItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitBackupSnapshotCompleted indicates an expected call of WaitBackupSnapshotCompleted.
+func (mr *MockCloudClientInterfaceMockRecorder) WaitBackupSnapshotCompleted(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitBackupSnapshotCompleted", reflect.TypeOf((*MockCloudClientInterface)(nil).WaitBackupSnapshotCompleted), arg0, arg1, arg2)
+}
+
+// WaitClusterRunning mocks base method.
+func (m *MockCloudClientInterface) WaitClusterRunning(arg0 context.Context, arg1 uuid.UUID) (*apigen1.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitClusterRunning", arg0, arg1)
+	ret0, _ := ret[0].(*apigen1.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitClusterRunning indicates an expected call of WaitClusterRunning.
+func (mr *MockCloudClientInterfaceMockRecorder) WaitClusterRunning(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitClusterRunning", reflect.TypeOf((*MockCloudClientInterface)(nil).WaitClusterRunning), arg0, arg1)
+}
+
+// WaitMatViewBackfilled mocks base method.
+func (m *MockCloudClientInterface) WaitMatViewBackfilled(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 string, arg4 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitMatViewBackfilled", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitMatViewBackfilled indicates an expected call of WaitMatViewBackfilled.
+func (mr *MockCloudClientInterfaceMockRecorder) WaitMatViewBackfilled(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitMatViewBackfilled", reflect.TypeOf((*MockCloudClientInterface)(nil).WaitMatViewBackfilled), arg0, arg1, arg2, arg3, arg4)
+}
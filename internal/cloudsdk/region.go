@@ -18,11 +18,23 @@ import (
 )
 
 var (
-	ErrClusterNotFound       = errors.New("cluster not found")
-	ErrBYOCClusterNotFound   = errors.New("BYOC cluster not found")
-	ErrClusterUserNotFound   = errors.New("cluster user not found")
-	ErrPrivateLinkNotFound   = errors.New("private link not found")
-	ErrResourceGroupNotFound = errors.New("resource group not found")
+	ErrClusterNotFound        = errors.New("cluster not found")
+	ErrBYOCClusterNotFound    = errors.New("BYOC cluster not found")
+	ErrBYOKClusterNotFound    = errors.New("BYOK cluster not found")
+	ErrClusterUserNotFound    = errors.New("cluster user not found")
+	ErrPrivateLinkNotFound    = errors.New("private link not found")
+	ErrResourceGroupNotFound  = errors.New("resource group not found")
+	ErrBackupSnapshotNotFound = errors.New("backup snapshot not found")
+	ErrClusterNotRunning      = errors.New("cluster is not running")
+	ErrDatabaseNotFound       = errors.New("database not found")
+	ErrSecretNotFound         = errors.New("secret not found")
+	ErrMatViewNotFound        = errors.New("materialized view not found")
+	ErrSourceNotFound         = errors.New("source not found")
+	ErrSinkNotFound           = errors.New("sink not found")
+	ErrSourceUnreachable      = errors.New("source upstream is unreachable")
+
+	ErrIcebergCompactionNotFound  = errors.New("iceberg compaction not found")
+	ErrServerlessBackfillNotFound = errors.New("serverless backfill not found")
 )
 
 const (
@@ -76,6 +88,73 @@ var (
 		Timeout:  30 * time.Second,
 		Interval: 2 * time.Second,
 	}
+
+	// A snapshot is taken by a workflow that checkpoints the whole cluster, so it takes as long
+	// as the cluster's state is large.
+	PollingBackupSnapshotCreation = wait.PollingParams{
+		Timeout:  30 * time.Minute,
+		Interval: 5 * time.Second,
+	}
+
+	PollingBackupSnapshotDeletion = wait.PollingParams{
+		Timeout:  5 * time.Minute,
+		Interval: 3 * time.Second,
+	}
+
+	// An in-place restore replays the snapshot into the cluster's own storage and restarts
+	// every component, so it takes about as long as taking the snapshot did.
+	PollingInPlaceRestore = wait.PollingParams{
+		Timeout:  30 * time.Minute,
+		Interval: 5 * time.Second,
+	}
+
+	// Enabling, updating or disabling an extension deploys or removes its workers, which takes
+	// about as long as a rescale.
+	PollingExtensionOperation = wait.PollingParams{
+		Timeout:  15 * time.Minute,
+		Interval: 3 * time.Second,
+	}
+
+	// Stopping or starting a cluster scales all of its components to or from zero.
+	PollingClusterPowerOperation = wait.PollingParams{
+		Timeout:  15 * time.Minute,
+		Interval: 3 * time.Second,
+	}
+
+	// Provisioning a BYOC environment creates the network, the Kubernetes cluster and the storage
+	// of the environment in the cloud account, and terminating it tears them down again, which
+	// takes a lot longer than anything done to a single cluster.
+	PollingBYOCClusterOperation = wait.PollingParams{
+		Timeout:  time.Hour,
+		Interval: 10 * time.Second,
+	}
+
+	// The backfill of a materialized view reads all the existing data of its upstreams, so how
+	// long it takes depends on the data rather than on the platform. The timeout is the default
+	// of the materialized view resource, which lets practitioners override it.
+	PollingMatViewBackfill = wait.PollingParams{
+		Timeout:  time.Hour,
+		Interval: 5 * time.Second,
+	}
+)
+
+// The status of the iceberg compaction extension is a plain string in the API spec, and is
+// compared case-insensitively since the spec does not pin its case.
+const (
+	IcebergCompactionStatusRunning  = "Running"
+	IcebergCompactionStatusFailed   = "Failed"
+	IcebergCompactionStatusDisabled = "Disabled"
+)
+
+// ServerlessBackfillStatusDisabled is reported by the serverless backfill extension when it is
+// not enabled. It is compared case-insensitively like the other extension statuses.
+const ServerlessBackfillStatusDisabled = "Disabled"
+
+// The status of a backup snapshot is a plain string in the API spec. The platform reports it
+// in upper case today, but it is compared case-insensitively since the spec does not pin it.
+const (
+	BackupSnapshotStatusCompleted = "COMPLETED"
+	BackupSnapshotStatusFailed    = "FAILED"
 )
 
 type RegionServiceClientInterface interface {
@@ -85,6 +164,12 @@ type RegionServiceClientInterface interface {
 
 	CreateClusterAwait(ctx context.Context, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error)
 
+	CreateClusterAwaitingConfig(ctx context.Context, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error)
+
+	UpdateClusterBYOKConfig(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) (*apigen_mgmtv2.Tenant, error)
+
+	UpdateClusterBYOKConfigAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) error
+
 	DeleteClusterAwait(ctx context.Context, nsID uuid.UUID) error
 
 	UpdateClusterImageAwait(ctx context.Context, nsID uuid.UUID, version string) error
@@ -105,6 +190,58 @@ type RegionServiceClientInterface interface {
 
 	DeleteClusterUser(ctx context.Context, nsID uuid.UUID, username string) error
 
+	GetDatabases(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.Database, error)
+
+	CreateDatabase(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.CreateDatabaseRequestBody) (*apigen_mgmtv2.Database, error)
+
+	DeleteDatabase(ctx context.Context, nsID uuid.UUID, name string) error
+
+	GetSecrets(ctx context.Context, nsID uuid.UUID, database string) ([]apigen_mgmtv2.Secret, error)
+
+	CreateSecret(ctx context.Context, nsID uuid.UUID, database string, req apigen_mgmtv2.CreateSecretRequestBody) error
+
+	DeleteSecret(ctx context.Context, nsID uuid.UUID, database, name string) error
+
+	GetSecretReferences(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	ExecuteSQLBatch(ctx context.Context, nsID uuid.UUID, database string, queries []string) ([]apigen_mgmtv2.BatchQueryResult, error)
+
+	QuerySQL(ctx context.Context, nsID uuid.UUID, database, query string) (*apigen_mgmtv2.QueryResult, error)
+
+	GetMatViews(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.MatView, error)
+
+	WaitMatViewBackfilled(ctx context.Context, nsID uuid.UUID, database, name string, timeout time.Duration) error
+
+	GetMatViewDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	DeleteMatView(ctx context.Context, nsID uuid.UUID, database, name string) error
+
+	GetSources(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.SourceInfo, error)
+
+	GetSourceDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	DeleteSource(ctx context.Context, nsID uuid.UUID, database, name string) error
+
+	GetSinks(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.SinkInfo, error)
+
+	GetSinkDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error)
+
+	DeleteSink(ctx context.Context, nsID uuid.UUID, database, name string) error
+
+	PingSource(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostSourcesPingRequestBody) error
+
+	FetchKafkaTopics(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig) ([]string, error)
+
+	FetchKafkaSchema(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig, topic string) ([]apigen_mgmtv2.ColumnDesc, error)
+
+	FetchPostgresTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.PostgresCdcConfig) ([]string, error)
+
+	FetchMysqlTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MysqlCdcConfig) ([]string, error)
+
+	FetchSqlserverTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.SqlserverCdcConfig) ([]string, error)
+
+	FetchMongodbCollections(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MongodbCdcConfig) ([]string, error)
+
 	GetPrivateLink(ctx context.Context, nsID, privateLinkID uuid.UUID) (*apigen_mgmtv2.PrivateLink, error)
 
 	CreatePrivateLinkAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostPrivateLinkRequestBody) (*apigen_mgmtv2.PrivateLink, error)
@@ -113,6 +250,26 @@ type RegionServiceClientInterface interface {
 
 	GetBYOCCluster(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error)
 
+	CreateBYOCCluster(ctx context.Context, req apigen_mgmtv2.PostByocClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error)
+
+	WaitBYOCClusterReady(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	UpdateBYOCCluster(ctx context.Context, name string, req apigen_mgmtv2.PutByocClusterRequestBody) error
+
+	UpdateBYOCClusterVersionAwait(ctx context.Context, name, version string) error
+
+	DeleteBYOCClusterAwait(ctx context.Context, name string) error
+
+	GetBYOKCluster(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	CreateBYOKCluster(ctx context.Context, req apigen_mgmtv2.PostByokClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error)
+
+	WaitBYOKClusterReady(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error)
+
+	UpdateBYOKClusterVersionAwait(ctx context.Context, name, version string) error
+
+	DeleteBYOKClusterAwait(ctx context.Context, name string) error
+
 	GetResourceGroups(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.ResourceGroupDetails, error)
 
 	CreateResourceGroupAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.CreateResourceGroupsRequestBody) (*apigen_mgmtv2.ResourceGroupDetails, error)
@@ -126,6 +283,76 @@ type RegionServiceClientInterface interface {
 	AddAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error
 
 	RemoveAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error
+
+	GetBackupSnapshots(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.BackupSnapshotItem, error)
+
+	GetBackupSnapshot(ctx context.Context, nsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error)
+
+	CreateBackupSnapshot(ctx context.Context, nsID uuid.UUID) (uuid.UUID, error)
+
+	WaitBackupSnapshotCompleted(ctx context.Context, nsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error)
+
+	DeleteBackupSnapshotAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error
+
+	RestoreCluster(ctx context.Context, nsID, snapshotID uuid.UUID, newClusterName string) (uuid.UUID, error)
+
+	WaitClusterRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Tenant, error)
+
+	InPlaceRestoreClusterAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error
+
+	GetMaintenanceWindow(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.MaintenanceWindow, error)
+
+	UpdateMaintenanceWindow(ctx context.Context, nsID uuid.UUID, window apigen_mgmtv2.MaintenanceWindow) error
+
+	StopClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	StartClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	RestartClusterAwait(ctx context.Context, nsID uuid.UUID) error
+
+	GetServerlessCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, error)
+
+	EnableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error
+
+	UpdateServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error
+
+	DisableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID) error
+
+	GetIcebergCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	EnableIcebergCompaction(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) error
+
+	WaitIcebergCompactionRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	UpdateIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen_mgmtv2.IcebergCompaction, error)
+
+	DisableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID) error
+
+	GetServerlessBackfill(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error)
+
+	EnableServerlessBackfill(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error
+
+	WaitServerlessBackfillEnabled(ctx context.Context, nsID uuid.UUID) error
+
+	UpdateServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error
+
+	DisableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID) error
+
+	GetComputeCache(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheResponseBody, error)
+
+	GetComputeCacheCapabilities(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, error)
+
+	UpdateComputeCacheAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantComputeCacheRequestBody) error
+
+	GetClusterCloudMetadata(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.TenantCloudMetadata, error)
+
+	GetClusterEndpoint(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Endpoint, error)
+
+	GetClusterCACertificate(ctx context.Context, nsID uuid.UUID) (string, error)
+
+	GetRootCACertificate(ctx context.Context) (string, error)
+
+	CreateClusterOAuthToken(ctx context.Context, nsID uuid.UUID) (string, error)
 }
 
 type RegionServiceClient struct {
@@ -200,6 +427,38 @@ func (c *RegionServiceClient) waitClusterRescaled(ctx context.Context, nsID uuid
 	return c.waitClusterHealthy(ctx, nsID)
 }
 
+// waitClusterOperation waits for an accepted operation that takes the cluster out of the running
+// status, a restore or an extension change for example, to complete. Like a rescale, the request
+// is picked up asynchronously and the cluster keeps reporting the running status for a short
+// while, so wait for it to leave that status first. It gives up as soon as the cluster fails.
+func (c *RegionServiceClient) waitClusterOperation(ctx context.Context, nsID uuid.UUID, params wait.PollingParams) error {
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetClusterByNsID(ctx, nsID)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get the cluster info")
+		}
+		return cluster.Status != apigen_mgmtv2.Running, nil
+	}, PollingRescaleStart); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+
+	var current apigen_mgmtv2.TenantStatus
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetClusterByNsID(ctx, nsID)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get the cluster info")
+		}
+		current = cluster.Status
+		if current == apigen_mgmtv2.Failed {
+			return false, errors.New("the platform reported the cluster as failed")
+		}
+		return current == apigen_mgmtv2.Running && cluster.HealthStatus == apigen_mgmtv2.Healthy, nil
+	}, params); err != nil {
+		return errors.Wrapf(err, "failed to wait for the cluster, current status: %s, target status: %s", current, apigen_mgmtv2.Running)
+	}
+	return nil
+}
+
 // this is used only when the cluster ID is unknown.
 func (c *RegionServiceClient) waitClusterStatusByNsID(ctx context.Context, nsID uuid.UUID, target apigen_mgmtv2.TenantStatus) error {
 	var currentStatus apigen_mgmtv2.TenantStatus
@@ -465,13 +724,42 @@ func (c *RegionServiceClient) DeleteClusterUser(ctx context.Context, nsID uuid.U
 	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
 }
 
-func (c *RegionServiceClient) GetPrivateLink(ctx context.Context, nsID, privateLinkID uuid.UUID) (*apigen_mgmtv2.PrivateLink, error) {
-	res, err := c.mgmtV2Client.GetTenantsNsIdPrivatelinksPrivateLinkIdWithResponse(ctx, nsID, privateLinkID)
+func (c *RegionServiceClient) GetDatabases(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.Database, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.Database
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesWithResponse(ctx, nsID, &apigen_mgmtv2.GetTenantsNsIdDatabasesParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call API to get databases")
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Databases...)
+		offset += uint64(len(res.JSON200.Databases))
+		if len(res.JSON200.Databases) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) CreateDatabase(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.CreateDatabaseRequestBody) (*apigen_mgmtv2.Database, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdDatabasesWithResponse(ctx, nsID, req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to call API to get private link %s", privateLinkID)
+		return nil, errors.Wrapf(err, "failed to call API to create database %s", req.Name)
 	}
 	if res.StatusCode() == http.StatusNotFound {
-		return nil, ErrPrivateLinkNotFound
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
 	}
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
@@ -479,212 +767,1587 @@ func (c *RegionServiceClient) GetPrivateLink(ctx context.Context, nsID, privateL
 	return res.JSON200, nil
 }
 
-func (c *RegionServiceClient) CreatePrivateLinkAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostPrivateLinkRequestBody) (*apigen_mgmtv2.PrivateLink, error) {
-	res, err := c.mgmtV2Client.PostTenantsNsIdPrivatelinksWithResponse(ctx, nsID, req)
+func (c *RegionServiceClient) DeleteDatabase(ctx context.Context, nsID uuid.UUID, name string) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdDatabasesDatabaseNameWithResponse(ctx, nsID, name)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to call API to create private link")
+		return errors.Wrapf(err, "failed to call API to delete database %s", name)
 	}
-	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
-		return nil, err
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
 	}
-	var info = res.JSON202
-	var rtn *apigen_mgmtv2.PrivateLink
-	err = wait.Poll(ctx, func() (bool, error) {
-		link, err := c.GetPrivateLink(ctx, nsID, info.Id)
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetSecrets(ctx context.Context, nsID uuid.UUID, database string) ([]apigen_mgmtv2.Secret, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.Secret
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSecretsWithResponse(ctx, nsID, database, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSecretsParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
 		if err != nil {
-			if errors.Is(err, ErrPrivateLinkNotFound) {
-				return false, nil
-			}
-			return false, err
+			return nil, errors.Wrapf(err, "failed to call API to get secrets in database %s", database)
 		}
-		rtn = link
-		if link.Status == apigen_mgmtv2.CREATED {
-			return true, nil
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
 		}
-		return false, nil
-	}, PollingPrivateLinkCreation)
-
-	if err != nil {
-		lastStatus := "<nil>"
-		if rtn != nil {
-			lastStatus = string(rtn.Status)
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Secrets...)
+		offset += uint64(len(res.JSON200.Secrets))
+		if len(res.JSON200.Secrets) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
 		}
-		return nil, errors.Wrapf(err, "failed to wait for the private link to be created, last status is %s", lastStatus)
 	}
 	return rtn, nil
 }
 
-func (c *RegionServiceClient) DeletePrivateLinkAwait(ctx context.Context, nsID, privateLinkID uuid.UUID) error {
-	res, err := c.mgmtV2Client.DeleteTenantsNsIdPrivatelinksPrivateLinkIdWithResponse(ctx, nsID, privateLinkID)
+func (c *RegionServiceClient) CreateSecret(ctx context.Context, nsID uuid.UUID, database string, req apigen_mgmtv2.CreateSecretRequestBody) error {
+	res, err := c.mgmtV2Client.PostTenantsNsIdDatabasesDatabaseNameSecretsWithResponse(ctx, nsID, database, req)
 	if err != nil {
-		return errors.Wrap(err, "failed to call API to delete private link")
+		return errors.Wrapf(err, "failed to call API to create secret %s", req.Name)
 	}
 	if res.StatusCode() == http.StatusNotFound {
-		return nil
+		return errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
 	}
-	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
-		return err
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) DeleteSecret(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdDatabasesDatabaseNameSecretsNameWithResponse(ctx, nsID, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete secret %s", name)
 	}
-	return wait.Poll(ctx, func() (bool, error) {
-		_, err := c.GetPrivateLink(ctx, nsID, privateLinkID)
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetSecretReferences(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.RwDependency
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSecretsSecretNameReferencesWithResponse(ctx, nsID, database, name, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSecretsSecretNameReferencesParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
 		if err != nil {
-			if errors.Is(err, ErrPrivateLinkNotFound) {
-				return true, nil
-			}
-			return false, err
+			return nil, errors.Wrapf(err, "failed to call API to get references of secret %s", name)
 		}
-		return false, nil
-	}, PollingPrivateLinkDeletion)
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrSecretNotFound, "secret %s in database %s", name, database)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.References...)
+		offset += uint64(len(res.JSON200.References))
+		if len(res.JSON200.References) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
 }
 
-func (c *RegionServiceClient) GetBYOCCluster(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error) {
-	res, err := c.mgmtV2Client.GetByocClustersNameWithResponse(ctx, name)
+func (c *RegionServiceClient) ExecuteSQLBatch(ctx context.Context, nsID uuid.UUID, database string, queries []string) ([]apigen_mgmtv2.BatchQueryResult, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdDatabasesDatabaseNameBatchSQLWithResponse(ctx, nsID, database, apigen_mgmtv2.SqlBatchRequestBody{
+		Queries: queries,
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to call API to get BYOC cluster")
+		return nil, errors.Wrapf(err, "failed to call API to execute SQL in database %s", database)
 	}
 	if res.StatusCode() == http.StatusNotFound {
-		return nil, errors.Wrapf(ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+		return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
 	}
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
-	return res.JSON200, nil
+	return res.JSON200.Results, nil
 }
 
-func (c *RegionServiceClient) GetResourceGroups(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.ResourceGroupDetails, error) {
-	res, err := c.mgmtV2Client.GetTenantsNsIdResourceGroupsWithResponse(ctx, nsID)
+func (c *RegionServiceClient) QuerySQL(ctx context.Context, nsID uuid.UUID, database, query string) (*apigen_mgmtv2.QueryResult, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdDatabasesDatabaseNameQuerySQLWithResponse(ctx, nsID, database, apigen_mgmtv2.SqlQueryRequestBody{
+		Query: query,
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to call API to get resource groups")
+		return nil, errors.Wrapf(err, "failed to call API to query database %s", database)
 	}
 	if res.StatusCode() == http.StatusNotFound {
-		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+		return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
 	}
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
-	return res.JSON200.ResourceGroups, nil
+	return res.JSON200, nil
 }
 
-func (c *RegionServiceClient) getResourceGroup(ctx context.Context, nsID uuid.UUID, name string) (*apigen_mgmtv2.ResourceGroupDetails, error) {
-	groups, err := c.GetResourceGroups(ctx, nsID)
-	if err != nil {
-		return nil, err
-	}
-	for _, g := range groups {
-		if g.Name == name {
-			return ptr.Ptr(g), nil
+func (c *RegionServiceClient) GetMatViews(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.MatView, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.MatView
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameMatviewsWithResponse(ctx, nsID, database, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameMatviewsParams{
+			Schema: &schema,
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call API to get materialized views in database %s", database)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.MatViews...)
+		offset += uint64(len(res.JSON200.MatViews))
+		if len(res.JSON200.MatViews) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
 		}
 	}
-	return nil, errors.Wrapf(ErrResourceGroupNotFound, "resource group %s", name)
+	return rtn, nil
 }
 
-// waitResourceGroupResource waits for the resource group to report the requested resource
-// spec. The returned details are written to the terraform state, so they must reflect the
-// applied spec instead of the one the cluster is rescaling away from.
-func (c *RegionServiceClient) waitResourceGroupResource(
-	ctx context.Context, nsID uuid.UUID, name string, expected apigen_mgmtv2.ComponentResourceRequest,
-) (*apigen_mgmtv2.ResourceGroupDetails, error) {
-	var rtn *apigen_mgmtv2.ResourceGroupDetails
+// WaitMatViewBackfilled waits until the backfill of the materialized view completes. The
+// progress is only tracked while the materialized view is being created, so a materialized view
+// without one has nothing left to backfill.
+func (c *RegionServiceClient) WaitMatViewBackfilled(ctx context.Context, nsID uuid.UUID, database, name string, timeout time.Duration) error {
+	var lastProgress = "<nil>"
 	if err := wait.Poll(ctx, func() (bool, error) {
-		g, err := c.getResourceGroup(ctx, nsID, name)
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameMatviewsMatViewNameProgressWithResponse(ctx, nsID, database, name)
 		if err != nil {
-			if errors.Is(err, ErrResourceGroupNotFound) {
-				return false, nil
-			}
-			return false, err
+			return false, errors.Wrapf(err, "failed to call API to get the progress of materialized view %s", name)
 		}
-		if g.Resource.ComponentTypeId != expected.ComponentTypeId || g.Resource.Replica != expected.Replica {
-			return false, nil
+		if res.StatusCode() == http.StatusNotFound {
+			return true, nil
 		}
-		rtn = g
-		return true, nil
-	}, PollingResourceGroupOperation); err != nil {
-		return nil, errors.Wrapf(
-			err,
-			"failed to wait for the resource group %s to report component type %s with %d replica(s)",
-			name, expected.ComponentTypeId, expected.Replica,
-		)
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return false, err
+		}
+		lastProgress = res.JSON200.Progress
+		return res.JSON200.Status == apigen_mgmtv2.Completed, nil
+	}, wait.PollingParams{
+		Timeout:  timeout,
+		Interval: PollingMatViewBackfill.Interval,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to wait for the backfill of materialized view %s, last progress is %s", name, lastProgress)
 	}
-	return rtn, nil
+	return nil
 }
 
-func (c *RegionServiceClient) CreateResourceGroupAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.CreateResourceGroupsRequestBody) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+func (c *RegionServiceClient) GetMatViewDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.RwDependency
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameMatviewsMatViewNameDependenciesWithResponse(ctx, nsID, database, name, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameMatviewsMatViewNameDependenciesParams{
+			Offset:              &offset,
+			Limit:               &limit,
+			DependencyDirection: apigen_mgmtv2.Downstream,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call API to get the dependencies of materialized view %s", name)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrMatViewNotFound, "materialized view %s in database %s", name, database)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Dependencies...)
+		offset += uint64(len(res.JSON200.Dependencies))
+		if len(res.JSON200.Dependencies) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) DeleteMatView(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdDatabasesDatabaseNameMatviewsMatViewNameWithResponse(ctx, nsID, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete materialized view %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetSources(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.SourceInfo, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSourcesWithResponse(ctx, nsID, database, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSourcesParams{
+		Schema: &schema,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to get sources in database %s", database)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Sources, nil
+}
+
+// GetSourceDownstreams returns the objects reading from the source. Unlike the required
+// dependencyDirection enum of the materialized view endpoint, the spec declares the type query
+// parameter of this one as an optional plain string without any values listed (see
+// GetTenantsNsIdDatabasesDatabaseNameSourcesSourceNameDependenciesParams.Type), so it is given
+// the same "downstream" value.
+func (c *RegionServiceClient) GetSourceDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	var (
+		offset         uint64 = 0
+		limit          uint64 = 50
+		dependencyType        = string(apigen_mgmtv2.Downstream)
+	)
+	var rtn []apigen_mgmtv2.RwDependency
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSourcesSourceNameDependenciesWithResponse(ctx, nsID, database, name, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSourcesSourceNameDependenciesParams{
+			Offset: &offset,
+			Limit:  &limit,
+			Type:   &dependencyType,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call API to get the dependencies of source %s", name)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrSourceNotFound, "source %s in database %s", name, database)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Dependencies...)
+		offset += uint64(len(res.JSON200.Dependencies))
+		if len(res.JSON200.Dependencies) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) DeleteSource(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdDatabasesDatabaseNameSourcesSourceNameWithResponse(ctx, nsID, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete source %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetSinks(ctx context.Context, nsID uuid.UUID, database, schema string) ([]apigen_mgmtv2.SinkInfo, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSinksWithResponse(ctx, nsID, database, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSinksParams{
+		Schema: &schema,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to get sinks in database %s", database)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrDatabaseNotFound, "database %s in cluster %s", database, nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Sinks, nil
+}
+
+// GetSinkDownstreams returns the objects depending on the sink, see GetSourceDownstreams.
+func (c *RegionServiceClient) GetSinkDownstreams(ctx context.Context, nsID uuid.UUID, database, name string) ([]apigen_mgmtv2.RwDependency, error) {
+	var (
+		offset         uint64 = 0
+		limit          uint64 = 50
+		dependencyType        = string(apigen_mgmtv2.Downstream)
+	)
+	var rtn []apigen_mgmtv2.RwDependency
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdDatabasesDatabaseNameSinksSinkNameDependenciesWithResponse(ctx, nsID, database, name, &apigen_mgmtv2.GetTenantsNsIdDatabasesDatabaseNameSinksSinkNameDependenciesParams{
+			Offset: &offset,
+			Limit:  &limit,
+			Type:   &dependencyType,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call API to get the dependencies of sink %s", name)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrSinkNotFound, "sink %s in database %s", name, database)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Dependencies...)
+		offset += uint64(len(res.JSON200.Dependencies))
+		if len(res.JSON200.Dependencies) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) DeleteSink(ctx context.Context, nsID uuid.UUID, database, name string) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdDatabasesDatabaseNameSinksSinkNameWithResponse(ctx, nsID, database, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete sink %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+// PingSource asks the cluster to connect to the upstream system of a source. It returns
+// ErrSourceUnreachable with the message of the platform if the upstream cannot be reached.
+func (c *RegionServiceClient) PingSource(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostSourcesPingRequestBody) error {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesPingWithResponse(ctx, nsID, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to ping %s source", req.Type)
+	}
+	if res.JSON400 != nil {
+		return errors.Errorf("invalid %s source configuration: %s", req.Type, res.JSON400.Msg)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return err
+	}
+	if !res.JSON200.Available {
+		return errors.Wrap(ErrSourceUnreachable, res.JSON200.Msg)
+	}
+	return nil
+}
+
+func (c *RegionServiceClient) FetchKafkaTopics(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig) ([]string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchKafkaTopicWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchKafkaTopicRequestBody{
+		Config: config,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to fetch kafka topics")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Topics, nil
+}
+
+// FetchKafkaSchema returns the columns inferred from the messages of the topic.
+func (c *RegionServiceClient) FetchKafkaSchema(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.KafkaConfig, topic string) ([]apigen_mgmtv2.ColumnDesc, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchKafkaSchemaWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchKafkaSchemaRequestBody{
+		KafkaConfig: config,
+		Topic:       topic,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to fetch the schema of kafka topic %s", topic)
+	}
+	if res.JSON400 != nil {
+		return nil, errors.Errorf("cannot fetch the schema of kafka topic %s: %s", topic, res.JSON400.Msg)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Columns, nil
+}
+
+func (c *RegionServiceClient) FetchPostgresTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.PostgresCdcConfig) ([]string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchPostgresTableWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchPostgresTableRequestBody{
+		Config: config,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to fetch postgres tables")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Tables, nil
+}
+
+func (c *RegionServiceClient) FetchMysqlTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MysqlCdcConfig) ([]string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchMysqlTableWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchMysqlTableRequestBody{
+		Config: config,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to fetch mysql tables")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Tables, nil
+}
+
+func (c *RegionServiceClient) FetchSqlserverTables(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.SqlserverCdcConfig) ([]string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchSqlserverTableWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchSqlserverTableRequestBody{
+		Config: config,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to fetch sqlserver tables")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Tables, nil
+}
+
+func (c *RegionServiceClient) FetchMongodbCollections(ctx context.Context, nsID uuid.UUID, config apigen_mgmtv2.MongodbCdcConfig) ([]string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdSourcesFetchMongodbCollectionWithResponse(ctx, nsID, apigen_mgmtv2.PostSourcesFetchMongodbCollectionRequestBody{
+		Config: config,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to fetch mongodb collections")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.Collections, nil
+}
+
+func (c *RegionServiceClient) GetPrivateLink(ctx context.Context, nsID, privateLinkID uuid.UUID) (*apigen_mgmtv2.PrivateLink, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdPrivatelinksPrivateLinkIdWithResponse(ctx, nsID, privateLinkID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to get private link %s", privateLinkID)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, ErrPrivateLinkNotFound
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *RegionServiceClient) CreatePrivateLinkAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostPrivateLinkRequestBody) (*apigen_mgmtv2.PrivateLink, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdPrivatelinksWithResponse(ctx, nsID, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to create private link")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return nil, err
+	}
+	var info = res.JSON202
+	var rtn *apigen_mgmtv2.PrivateLink
+	err = wait.Poll(ctx, func() (bool, error) {
+		link, err := c.GetPrivateLink(ctx, nsID, info.Id)
+		if err != nil {
+			if errors.Is(err, ErrPrivateLinkNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		rtn = link
+		if link.Status == apigen_mgmtv2.CREATED {
+			return true, nil
+		}
+		return false, nil
+	}, PollingPrivateLinkCreation)
+
+	if err != nil {
+		lastStatus := "<nil>"
+		if rtn != nil {
+			lastStatus = string(rtn.Status)
+		}
+		return nil, errors.Wrapf(err, "failed to wait for the private link to be created, last status is %s", lastStatus)
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) DeletePrivateLinkAwait(ctx context.Context, nsID, privateLinkID uuid.UUID) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdPrivatelinksPrivateLinkIdWithResponse(ctx, nsID, privateLinkID)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to delete private link")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return wait.Poll(ctx, func() (bool, error) {
+		_, err := c.GetPrivateLink(ctx, nsID, privateLinkID)
+		if err != nil {
+			if errors.Is(err, ErrPrivateLinkNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, PollingPrivateLinkDeletion)
+}
+
+func (c *RegionServiceClient) GetBYOCCluster(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	res, err := c.mgmtV2Client.GetByocClustersNameWithResponse(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get BYOC cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *RegionServiceClient) CreateBYOCCluster(ctx context.Context, req apigen_mgmtv2.PostByocClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	res, err := c.mgmtV2Client.PostByocClustersWithResponse(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to create BYOC cluster %s", req.Name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// WaitBYOCClusterReady waits for the BYOC cluster to be ready. It gives up as soon as the
+// cluster fails.
+func (c *RegionServiceClient) WaitBYOCClusterReady(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	var rtn *apigen_mgmtv2.ManagedCluster
+	err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOCCluster(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		rtn = cluster
+		if cluster.Status == apigen_mgmtv2.ClusterStatusFailed {
+			return false, errors.Errorf("BYOC cluster %s failed", name)
+		}
+		return cluster.Status == apigen_mgmtv2.ClusterStatusReady, nil
+	}, PollingBYOCClusterOperation)
+	if err != nil {
+		lastStatus := "<nil>"
+		if rtn != nil {
+			lastStatus = string(rtn.Status)
+		}
+		return nil, errors.Wrapf(err, "failed to wait for the BYOC cluster to be ready, last status is %s", lastStatus)
+	}
+	return rtn, nil
+}
+
+// UpdateBYOCCluster replaces the settings of the BYOC cluster with the ones in the request.
+func (c *RegionServiceClient) UpdateBYOCCluster(ctx context.Context, name string, req apigen_mgmtv2.PutByocClusterRequestBody) error {
+	res, err := c.mgmtV2Client.PutByocClustersNameWithResponse(ctx, name, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to update BYOC cluster %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+// UpdateBYOCClusterVersionAwait upgrades the BYOC cluster to the version and waits for it to be
+// ready again. Like a rescale, the request is picked up asynchronously, so wait for the cluster
+// to leave the ready status first.
+func (c *RegionServiceClient) UpdateBYOCClusterVersionAwait(ctx context.Context, name, version string) error {
+	res, err := c.mgmtV2Client.PostByocClustersNameUpdateWithResponse(ctx, name, apigen_mgmtv2.PostByocClusterUpdateRequestBody{
+		Version: &version,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to update the version of BYOC cluster %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrBYOCClusterNotFound, "BYOC cluster %s not found", name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOCCluster(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return cluster.Status != apigen_mgmtv2.ClusterStatusReady, nil
+	}, PollingRescaleStart); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+	_, err = c.WaitBYOCClusterReady(ctx, name)
+	return err
+}
+
+// DeleteBYOCClusterAwait terminates the BYOC cluster, which tears down its resources in the
+// cloud account, waits for it to be terminated and then deletes it. It returns nil if the BYOC
+// cluster does not exist.
+func (c *RegionServiceClient) DeleteBYOCClusterAwait(ctx context.Context, name string) error {
+	cluster, err := c.GetBYOCCluster(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrBYOCClusterNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if cluster.Status != apigen_mgmtv2.ClusterStatusDeleted && cluster.Status != apigen_mgmtv2.ClusterStatusTerminating {
+		res, err := c.mgmtV2Client.PostByocClustersNameTerminateWithResponse(ctx, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to call API to terminate BYOC cluster %s", name)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+			return err
+		}
+	}
+
+	current := cluster.Status
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOCCluster(ctx, name)
+		if err != nil {
+			if errors.Is(err, ErrBYOCClusterNotFound) {
+				current = apigen_mgmtv2.ClusterStatusDeleted
+				return true, nil
+			}
+			return false, err
+		}
+		current = cluster.Status
+		if current == apigen_mgmtv2.ClusterStatusFailed {
+			return false, errors.Errorf("failed to terminate BYOC cluster %s", name)
+		}
+		return current == apigen_mgmtv2.ClusterStatusDeleted, nil
+	}, PollingBYOCClusterOperation); err != nil {
+		return errors.Wrapf(err, "failed to wait for the BYOC cluster to be terminated, last status is %s", current)
+	}
+
+	res, err := c.mgmtV2Client.DeleteByocClustersNameWithResponse(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete BYOC cluster %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetBYOKCluster(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	res, err := c.mgmtV2Client.GetByokClustersNameWithResponse(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get BYOK cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrBYOKClusterNotFound, "BYOK cluster %s not found", name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *RegionServiceClient) CreateBYOKCluster(ctx context.Context, req apigen_mgmtv2.PostByokClustersRequestBody) (*apigen_mgmtv2.ManagedCluster, error) {
+	res, err := c.mgmtV2Client.PostByokClustersWithResponse(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to create BYOK cluster %s", req.Name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// WaitBYOKClusterReady waits for the BYOK cluster to be ready. It gives up as soon as the
+// cluster fails.
+func (c *RegionServiceClient) WaitBYOKClusterReady(ctx context.Context, name string) (*apigen_mgmtv2.ManagedCluster, error) {
+	var rtn *apigen_mgmtv2.ManagedCluster
+	err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOKCluster(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		rtn = cluster
+		if cluster.Status == apigen_mgmtv2.ClusterStatusFailed {
+			return false, errors.Errorf("BYOK cluster %s failed", name)
+		}
+		return cluster.Status == apigen_mgmtv2.ClusterStatusReady, nil
+	}, PollingBYOCClusterOperation)
+	if err != nil {
+		lastStatus := "<nil>"
+		if rtn != nil {
+			lastStatus = string(rtn.Status)
+		}
+		return nil, errors.Wrapf(err, "failed to wait for the BYOK cluster to be ready, last status is %s", lastStatus)
+	}
+	return rtn, nil
+}
+
+// UpdateBYOKClusterVersionAwait upgrades the BYOK cluster to the version and waits for it to be
+// ready again, the same way as UpdateBYOCClusterVersionAwait.
+func (c *RegionServiceClient) UpdateBYOKClusterVersionAwait(ctx context.Context, name, version string) error {
+	res, err := c.mgmtV2Client.PostByokClustersNameUpdateWithResponse(ctx, name, apigen_mgmtv2.PostByokClusterUpdateRequestBody{
+		Version: &version,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to update the version of BYOK cluster %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrBYOKClusterNotFound, "BYOK cluster %s not found", name)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOKCluster(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		return cluster.Status != apigen_mgmtv2.ClusterStatusReady, nil
+	}, PollingRescaleStart); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+	_, err = c.WaitBYOKClusterReady(ctx, name)
+	return err
+}
+
+// DeleteBYOKClusterAwait terminates the BYOK cluster, waits for it to be terminated and then
+// deletes it. It returns nil if the BYOK cluster does not exist.
+func (c *RegionServiceClient) DeleteBYOKClusterAwait(ctx context.Context, name string) error {
+	cluster, err := c.GetBYOKCluster(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrBYOKClusterNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if cluster.Status != apigen_mgmtv2.ClusterStatusDeleted && cluster.Status != apigen_mgmtv2.ClusterStatusTerminating {
+		res, err := c.mgmtV2Client.PostByokClustersNameTerminateWithResponse(ctx, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to call API to terminate BYOK cluster %s", name)
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+			return err
+		}
+	}
+
+	current := cluster.Status
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetBYOKCluster(ctx, name)
+		if err != nil {
+			if errors.Is(err, ErrBYOKClusterNotFound) {
+				current = apigen_mgmtv2.ClusterStatusDeleted
+				return true, nil
+			}
+			return false, err
+		}
+		current = cluster.Status
+		if current == apigen_mgmtv2.ClusterStatusFailed {
+			return false, errors.Errorf("failed to terminate BYOK cluster %s", name)
+		}
+		return current == apigen_mgmtv2.ClusterStatusDeleted, nil
+	}, PollingBYOCClusterOperation); err != nil {
+		return errors.Wrapf(err, "failed to wait for the BYOK cluster to be terminated, last status is %s", current)
+	}
+
+	res, err := c.mgmtV2Client.DeleteByokClustersNameWithResponse(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete BYOK cluster %s", name)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+func (c *RegionServiceClient) GetResourceGroups(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.ResourceGroupDetails, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdResourceGroupsWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get resource groups")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200.ResourceGroups, nil
+}
+
+func (c *RegionServiceClient) getResourceGroup(ctx context.Context, nsID uuid.UUID, name string) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+	groups, err := c.GetResourceGroups(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		if g.Name == name {
+			return ptr.Ptr(g), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrResourceGroupNotFound, "resource group %s", name)
+}
+
+// waitResourceGroupResource waits for the resource group to report the requested resource
+// spec. The returned details are written to the terraform state, so they must reflect the
+// applied spec instead of the one the cluster is rescaling away from.
+func (c *RegionServiceClient) waitResourceGroupResource(
+	ctx context.Context, nsID uuid.UUID, name string, expected apigen_mgmtv2.ComponentResourceRequest,
+) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+	var rtn *apigen_mgmtv2.ResourceGroupDetails
+	if err := wait.Poll(ctx, func() (bool, error) {
+		g, err := c.getResourceGroup(ctx, nsID, name)
+		if err != nil {
+			if errors.Is(err, ErrResourceGroupNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		if g.Resource.ComponentTypeId != expected.ComponentTypeId || g.Resource.Replica != expected.Replica {
+			return false, nil
+		}
+		rtn = g
+		return true, nil
+	}, PollingResourceGroupOperation); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"failed to wait for the resource group %s to report component type %s with %d replica(s)",
+			name, expected.ComponentTypeId, expected.Replica,
+		)
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) CreateResourceGroupAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.CreateResourceGroupsRequestBody) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return nil, err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdResourceGroupsWithResponse(ctx, nsID, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to create resource group")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return nil, err
+	}
+	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
+		return nil, err
+	}
+	return c.waitResourceGroupResource(ctx, nsID, req.Name, req.Resource)
+}
+
+func (c *RegionServiceClient) UpdateResourceGroupAwait(ctx context.Context, nsID uuid.UUID, resourceGroup string, req apigen_mgmtv2.UpdateResourceGroupsRequestBody) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return nil, err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdResourceGroupsResourceGroupWithResponse(ctx, nsID, resourceGroup, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call API to update resource group %s", resourceGroup)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrResourceGroupNotFound, "resource group %s", resourceGroup)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return nil, err
+	}
+	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
+		return nil, err
+	}
+	return c.waitResourceGroupResource(ctx, nsID, resourceGroup, req.Resource)
+}
+
+func (c *RegionServiceClient) DeleteResourceGroupAwait(ctx context.Context, nsID uuid.UUID, resourceGroup string) error {
 	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdResourceGroupsResourceGroupWithResponse(ctx, nsID, resourceGroup)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete resource group %s", resourceGroup)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	// A rejected deletion (the databases running in the group have to be dropped first) comes
+	// back as 400 with a message that already names them, so pass the body through as is.
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
+		return err
+	}
+	return wait.Poll(ctx, func() (bool, error) {
+		_, err := c.getResourceGroup(ctx, nsID, resourceGroup)
+		if err != nil {
+			if errors.Is(err, ErrResourceGroupNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, PollingResourceGroupOperation)
+}
+
+// allowedIamRoles reports the ARNs currently permitted to assume a role into the customer's
+// account, together with the state the platform is in while applying a change.
+func (c *RegionServiceClient) allowedIamRoles(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantAllowedIamRolesResponseBody, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the allowed IAM roles")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *RegionServiceClient) GetAllowedIamRoles(ctx context.Context, nsID uuid.UUID) ([]string, error) {
+	roles, err := c.allowedIamRoles(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+	return roles.RoleArns, nil
+}
+
+// pollAllowedIamRoles checks once before polling, since wait.Poll sleeps for a whole interval
+// before its first attempt and every mutation would otherwise pay for it twice.
+func pollAllowedIamRoles(ctx context.Context, check func() (bool, error)) error {
+	if done, err := check(); err != nil || done {
+		return err
+	}
+	return wait.Poll(ctx, check, PollingAllowedIamRoleOperation)
+}
+
+// readyAllowedIamRoles reports whether the policy has settled, and gives up on `Failed`:
+// polling on would only spend the whole budget to report a timeout instead of the failure the
+// platform already knows about.
+func (c *RegionServiceClient) readyAllowedIamRoles(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantAllowedIamRolesResponseBody, bool, error) {
+	roles, err := c.allowedIamRoles(ctx, nsID)
+	if err != nil {
+		return nil, false, err
+	}
+	switch roles.Status {
+	case apigen_mgmtv2.GetTenantAllowedIamRolesResponseBodyStatusReady:
+		return roles, true, nil
+	case apigen_mgmtv2.GetTenantAllowedIamRolesResponseBodyStatusFailed:
+		return nil, false, errors.Errorf("the platform failed to apply the allowed IAM roles of cluster %s", nsID)
+	default:
+		return roles, false, nil
+	}
+}
+
+// waitAllowedIamRoles waits until the IAM policy can accept another change. The platform
+// answers a request that overlaps another with a 500, so this runs before every mutation.
+func (c *RegionServiceClient) waitAllowedIamRoles(ctx context.Context, nsID uuid.UUID) error {
+	if err := pollAllowedIamRoles(ctx, func() (bool, error) {
+		_, ready, err := c.readyAllowedIamRoles(ctx, nsID)
+		return ready, err
+	}); err != nil {
+		return errors.Wrap(err, "failed to wait for the allowed IAM roles to settle")
+	}
+	return nil
+}
+
+// waitAllowedIamRoleApplied waits until the list itself shows the change, not merely until the
+// status says the policy has settled.
+//
+// The two are not the same. A check that runs right after a request is accepted can read a
+// status that has not moved yet, and answer with the list as it was. The platform updates the
+// record before the status today, so this is a belt rather than a fix, but membership is the
+// thing the caller actually asked about and it does not depend on that ordering holding.
+func (c *RegionServiceClient) waitAllowedIamRoleApplied(ctx context.Context, nsID uuid.UUID, roleArn string, want bool) error {
+	if err := pollAllowedIamRoles(ctx, func() (bool, error) {
+		roles, ready, err := c.readyAllowedIamRoles(ctx, nsID)
+		if err != nil || !ready {
+			return false, err
+		}
+		return slices.Contains(roles.RoleArns, roleArn) == want, nil
+	}); err != nil {
+		verb := "allowed"
+		if !want {
+			verb = "removed"
+		}
+		return errors.Wrapf(err, "failed to wait for the IAM role %s to be %s", roleArn, verb)
+	}
+	return nil
+}
+
+func (c *RegionServiceClient) AddAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error {
+	if err := c.waitAllowedIamRoles(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID, apigen_mgmtv2.TenantAllowedIamRoleRequestBody{
+		RoleArn: roleArn,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to allow the IAM role %s", roleArn)
+	}
+	// the role is already allowed, which is the state the caller asked for
+	if res.StatusCode() == http.StatusConflict {
+		return nil
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitAllowedIamRoleApplied(ctx, nsID, roleArn, true)
+}
+
+func (c *RegionServiceClient) RemoveAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error {
+	if err := c.waitAllowedIamRoles(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID, apigen_mgmtv2.TenantAllowedIamRoleRequestBody{
+		RoleArn: roleArn,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to remove the IAM role %s", roleArn)
+	}
+	// already gone, which is the state the caller asked for
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitAllowedIamRoleApplied(ctx, nsID, roleArn, false)
+}
+
+func (c *RegionServiceClient) GetBackupSnapshots(ctx context.Context, nsID uuid.UUID) ([]apigen_mgmtv2.BackupSnapshotItem, error) {
+	var (
+		offset uint64 = 0
+		limit  uint64 = 50
+	)
+	var rtn []apigen_mgmtv2.BackupSnapshotItem
+	for {
+		res, err := c.mgmtV2Client.GetTenantsNsIdBackupsWithResponse(ctx, nsID, &apigen_mgmtv2.GetTenantsNsIdBackupsParams{
+			Offset: &offset,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to call API to get backup snapshots")
+		}
+		if res.StatusCode() == http.StatusNotFound {
+			return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+		}
+		if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+			return nil, err
+		}
+		rtn = append(rtn, res.JSON200.Items...)
+		offset += uint64(len(res.JSON200.Items))
+		if len(res.JSON200.Items) == 0 || res.JSON200.Pagination == nil || offset >= res.JSON200.Pagination.Size {
+			break
+		}
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) GetBackupSnapshot(ctx context.Context, nsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	snapshots, err := c.GetBackupSnapshots(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snapshots {
+		if s.Id == snapshotID {
+			return ptr.Ptr(s), nil
+		}
+	}
+	return nil, errors.Wrapf(ErrBackupSnapshotNotFound, "snapshot %s in cluster %s", snapshotID, nsID)
+}
+
+// CreateBackupSnapshot only starts the snapshot. It returns as soon as the workflow is
+// accepted, so that the caller can record the snapshot before waiting for it.
+func (c *RegionServiceClient) CreateBackupSnapshot(ctx context.Context, nsID uuid.UUID) (uuid.UUID, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdBackupsWithResponse(ctx, nsID)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "failed to call API to create backup snapshot")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return uuid.Nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return uuid.Nil, err
+	}
+	return res.JSON202.SnapshotId, nil
+}
+
+// WaitBackupSnapshotCompleted waits for the snapshot workflow to finish. The v2 API has no
+// endpoint to query the workflow itself, so this follows the status of the snapshot it creates,
+// and gives up as soon as the snapshot reports a failure instead of polling until the timeout.
+func (c *RegionServiceClient) WaitBackupSnapshotCompleted(ctx context.Context, nsID, snapshotID uuid.UUID) (*apigen_mgmtv2.BackupSnapshotItem, error) {
+	var rtn *apigen_mgmtv2.BackupSnapshotItem
+	if err := wait.Poll(ctx, func() (bool, error) {
+		s, err := c.GetBackupSnapshot(ctx, nsID, snapshotID)
+		if err != nil {
+			// the snapshot is listed once the workflow records it, which may lag behind the request
+			if errors.Is(err, ErrBackupSnapshotNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		rtn = s
+		switch {
+		case strings.EqualFold(s.Status, BackupSnapshotStatusCompleted):
+			return true, nil
+		case strings.EqualFold(s.Status, BackupSnapshotStatusFailed):
+			return false, errors.Errorf("the platform failed to take the snapshot %s of cluster %s", snapshotID, nsID)
+		default:
+			return false, nil
+		}
+	}, PollingBackupSnapshotCreation); err != nil {
+		lastStatus := "<nil>"
+		if rtn != nil {
+			lastStatus = rtn.Status
+		}
+		return nil, errors.Wrapf(err, "failed to wait for the backup snapshot %s to complete, last status is %s", snapshotID, lastStatus)
+	}
+	return rtn, nil
+}
+
+func (c *RegionServiceClient) DeleteBackupSnapshotAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error {
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdBackupsSnapshotIdWithResponse(ctx, nsID, snapshotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to delete backup snapshot %s", snapshotID)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return wait.Poll(ctx, func() (bool, error) {
+		_, err := c.GetBackupSnapshot(ctx, nsID, snapshotID)
+		if err != nil {
+			if errors.Is(err, ErrBackupSnapshotNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, PollingBackupSnapshotDeletion)
+}
+
+// RestoreCluster restores the snapshot into a new cluster in the same region. The platform does
+// not return the new cluster in the response, so it is looked up by its name, which only waits
+// for the restore workflow to create the cluster, not for the cluster to be running.
+func (c *RegionServiceClient) RestoreCluster(ctx context.Context, nsID, snapshotID uuid.UUID, newClusterName string) (uuid.UUID, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdBackupsSnapshotIdRestoreWithResponse(ctx, nsID, snapshotID, apigen_mgmtv2.PostTenantRestoreRequestBody{
+		NewTenantName: newClusterName,
+	})
+	if err != nil {
+		return uuid.Nil, errors.Wrapf(err, "failed to call API to restore backup snapshot %s", snapshotID)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return uuid.Nil, errors.Wrapf(ErrBackupSnapshotNotFound, "snapshot %s of cluster %s: %s", snapshotID, nsID, string(res.Body))
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return uuid.Nil, err
+	}
+
+	// the new cluster is listed once the restore workflow creates it
+	var restored *apigen_mgmtv1.Tenant
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetClusterByName(ctx, newClusterName)
+		if err != nil {
+			if errors.Is(err, ErrClusterNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		restored = cluster
+		return true, nil
+	}, PollingTenantCreation); err != nil {
+		return uuid.Nil, errors.Wrapf(err, "failed to wait for the cluster %s to be restored", newClusterName)
+	}
+	return restored.NsId, nil
+}
+
+func (c *RegionServiceClient) WaitClusterRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Tenant, error) {
+	if err := c.waitClusterStatusByNsID(ctx, nsID, apigen_mgmtv2.Running); err != nil {
+		return nil, err
+	}
+	if err := c.waitClusterHealthStatusByNsID(ctx, nsID, apigen_mgmtv2.Healthy); err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.GetClusterByNsID(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster info")
+	}
+	return cluster, nil
+}
+
+// CreateClusterAwaitingConfig creates a BYOK cluster and waits for it to await its BYOK
+// configuration. The platform does not deploy a BYOK cluster before it has one, and the
+// configuration may depend on the cluster, e.g. on the trust policy of the IAM role for its
+// service account.
+func (c *RegionServiceClient) CreateClusterAwaitingConfig(ctx context.Context, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	createRes, err := c.mgmtV2Client.PostTenantsWithResponse(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed call API to to create cluster")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(createRes, http.StatusAccepted, string(createRes.Body)); err != nil {
+		return nil, err
+	}
+
+	if err := c.waitClusterStatusByNsID(ctx, createRes.JSON202.NsId, apigen_mgmtv2.AwaitingConfig); err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.GetClusterByNsID(ctx, createRes.JSON202.NsId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster info")
+	}
+	return cluster, nil
+}
+
+// UpdateClusterBYOKConfig sets the BYOK configuration of the cluster without waiting for the
+// cluster to pick it up.
+func (c *RegionServiceClient) UpdateClusterBYOKConfig(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) (*apigen_mgmtv2.Tenant, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdByokConfigWithResponse(ctx, nsID, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to update the BYOK configuration of the cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON202, nil
+}
+
+// UpdateClusterBYOKConfigAwait sets the BYOK configuration of a deployed cluster and waits for
+// the cluster to be running and healthy again.
+func (c *RegionServiceClient) UpdateClusterBYOKConfigAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostBYOKTenantConfigRequestBody) error {
+	if _, err := c.UpdateClusterBYOKConfig(ctx, nsID, req); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingTenantCreation)
+}
+
+// InPlaceRestoreClusterAwait restores the snapshot into the cluster it was taken of, replacing
+// all of the cluster's data, and waits for the cluster to be running and healthy again. It
+// refuses to start unless the cluster is running: the platform would otherwise queue the
+// restore behind whatever operation is in flight, and its outcome would depend on it.
+func (c *RegionServiceClient) InPlaceRestoreClusterAwait(ctx context.Context, nsID, snapshotID uuid.UUID) error {
+	cluster, err := c.GetClusterByNsID(ctx, nsID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster info")
+	}
+	if cluster.Status != apigen_mgmtv2.Running {
+		return errors.Wrapf(ErrClusterNotRunning, "cluster %s, current status: %s", nsID, cluster.Status)
+	}
+
+	res, err := c.mgmtV2Client.PostTenantsNsIdBackupsSnapshotIdInPlaceRestoreWithResponse(ctx, nsID, snapshotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call API to restore backup snapshot %s in place", snapshotID)
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrBackupSnapshotNotFound, "snapshot %s of cluster %s: %s", snapshotID, nsID, string(res.Body))
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+
+	if err := c.waitClusterOperation(ctx, nsID, PollingInPlaceRestore); err != nil {
+		return errors.Wrapf(err, "failed to restore snapshot %s into cluster %s", snapshotID, nsID)
+	}
+	return nil
+}
+
+func (c *RegionServiceClient) GetMaintenanceWindow(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.MaintenanceWindow, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdMaintenanceWindowWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the maintenance window")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
-	res, err := c.mgmtV2Client.PostTenantsNsIdResourceGroupsWithResponse(ctx, nsID, req)
+	return res.JSON200, nil
+}
+
+// UpdateMaintenanceWindow replaces the maintenance window of the cluster. It only changes when
+// the platform may schedule maintenance, so there is nothing to wait for.
+func (c *RegionServiceClient) UpdateMaintenanceWindow(ctx context.Context, nsID uuid.UUID, window apigen_mgmtv2.MaintenanceWindow) error {
+	res, err := c.mgmtV2Client.PostTenantsNsIdMaintenanceWindowWithResponse(ctx, nsID, window)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to update the maintenance window")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body))
+}
+
+// StopClusterAwait stops a running cluster and waits for it to be stopped. The platform only
+// stops a cluster that is running, so it waits for any operation in flight first.
+func (c *RegionServiceClient) StopClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdStopWithResponse(ctx, nsID)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to stop the cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+
+	var current apigen_mgmtv2.TenantStatus
+	if err := wait.Poll(ctx, func() (bool, error) {
+		cluster, err := c.GetClusterByNsID(ctx, nsID)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get the cluster info")
+		}
+		current = cluster.Status
+		if current == apigen_mgmtv2.Failed {
+			return false, errors.New("the platform reported the cluster as failed")
+		}
+		return current == apigen_mgmtv2.Stopped, nil
+	}, PollingClusterPowerOperation); err != nil {
+		return errors.Wrapf(err, "failed to wait for the cluster, current status: %s, target status: %s", current, apigen_mgmtv2.Stopped)
+	}
+	return nil
+}
+
+// StartClusterAwait starts a stopped cluster and waits for it to be running and healthy.
+func (c *RegionServiceClient) StartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	res, err := c.mgmtV2Client.PostTenantsNsIdStartWithResponse(ctx, nsID)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to start the cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingClusterPowerOperation)
+}
+
+// RestartClusterAwait restarts all nodes of the cluster, one after another, and waits for the
+// cluster to be healthy again. The platform only restarts a running cluster, so it waits for any
+// operation in flight first.
+func (c *RegionServiceClient) RestartClusterAwait(ctx context.Context, nsID uuid.UUID) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdRestartWithResponse(ctx, nsID)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to restart the cluster")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterRescaled(ctx, nsID)
+}
+
+func (c *RegionServiceClient) GetServerlessCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdExtensionsCompactionWithResponse(ctx, nsID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to call API to create resource group")
+		return nil, errors.Wrap(err, "failed to call API to get the serverless compaction extension")
 	}
 	if res.StatusCode() == http.StatusNotFound {
 		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
 	}
-	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
-	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
+	return res.JSON200, nil
+}
+
+func (c *RegionServiceClient) EnableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdExtensionsCompactionWithResponse(ctx, nsID, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to enable the serverless compaction extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
+}
+
+func (c *RegionServiceClient) UpdateServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PutTenantsNsIdExtensionsCompactionWithResponse(ctx, nsID, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to update the serverless compaction extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
+}
+
+func (c *RegionServiceClient) DisableServerlessCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdExtensionsCompactionWithResponse(ctx, nsID)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to disable the serverless compaction extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
+}
+
+// GetIcebergCompaction returns ErrIcebergCompactionNotFound unless the extension is enabled.
+func (c *RegionServiceClient) GetIcebergCompaction(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdExtensionsIcebergCompactionWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the iceberg compaction extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrIcebergCompactionNotFound, "cluster %s", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
-	return c.waitResourceGroupResource(ctx, nsID, req.Name, req.Resource)
+	if strings.EqualFold(res.JSON200.Status, IcebergCompactionStatusDisabled) {
+		return nil, errors.Wrapf(ErrIcebergCompactionNotFound, "cluster %s", nsID)
+	}
+	return res.JSON200, nil
 }
 
-func (c *RegionServiceClient) UpdateResourceGroupAwait(ctx context.Context, nsID uuid.UUID, resourceGroup string, req apigen_mgmtv2.UpdateResourceGroupsRequestBody) (*apigen_mgmtv2.ResourceGroupDetails, error) {
+// WaitIcebergCompactionRunning waits for the extension to settle on the running status. The
+// extension may not be listed for a short while after it is enabled, and it gives up as soon
+// as the platform reports the extension as failed.
+func (c *RegionServiceClient) WaitIcebergCompactionRunning(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.IcebergCompaction, error) {
+	var (
+		compaction *apigen_mgmtv2.IcebergCompaction
+		current    string
+	)
+	if err := wait.Poll(ctx, func() (bool, error) {
+		var err error
+		compaction, err = c.GetIcebergCompaction(ctx, nsID)
+		if err != nil {
+			if errors.Is(err, ErrIcebergCompactionNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		current = compaction.Status
+		if strings.EqualFold(current, IcebergCompactionStatusFailed) {
+			return false, errors.Errorf("the platform failed to apply the iceberg compaction extension of cluster %s", nsID)
+		}
+		return strings.EqualFold(current, IcebergCompactionStatusRunning), nil
+	}, PollingExtensionOperation); err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for the iceberg compaction extension, current status: %s, target status: %s", current, IcebergCompactionStatusRunning)
+	}
+	return compaction, nil
+}
+
+// EnableIcebergCompaction returns as soon as the platform accepts the request, use
+// WaitIcebergCompactionRunning to wait for the compactor nodes.
+func (c *RegionServiceClient) EnableIcebergCompaction(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdExtensionsIcebergCompactionWithResponse(ctx, nsID, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to enable the iceberg compaction extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body))
+}
+
+func (c *RegionServiceClient) UpdateIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody) (*apigen_mgmtv2.IcebergCompaction, error) {
 	if err := c.waitClusterIdle(ctx, nsID); err != nil {
 		return nil, err
 	}
-	res, err := c.mgmtV2Client.PostTenantsNsIdResourceGroupsResourceGroupWithResponse(ctx, nsID, resourceGroup, req)
+	res, err := c.mgmtV2Client.PutTenantsNsIdExtensionsIcebergCompactionWithResponse(ctx, nsID, req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to call API to update resource group %s", resourceGroup)
+		return nil, errors.Wrap(err, "failed to call API to update the iceberg compaction extension")
 	}
 	if res.StatusCode() == http.StatusNotFound {
-		return nil, errors.Wrapf(ErrResourceGroupNotFound, "resource group %s", resourceGroup)
+		return nil, errors.Wrapf(ErrIcebergCompactionNotFound, "cluster %s", nsID)
 	}
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
 		return nil, err
 	}
-	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
+
+	// like a rescale, the update is picked up asynchronously and the extension keeps reporting
+	// the running status for a short while. Not observing the transition is not an error, the
+	// update may already be done. The extension may also read as not found or disabled while
+	// the compactor is redeployed, which is a transition as well.
+	if err := wait.Poll(ctx, func() (bool, error) {
+		compaction, err := c.GetIcebergCompaction(ctx, nsID)
+		if err != nil {
+			if errors.Is(err, ErrIcebergCompactionNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		return !strings.EqualFold(compaction.Status, IcebergCompactionStatusRunning), nil
+	}, PollingRescaleStart); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
 		return nil, err
 	}
-	return c.waitResourceGroupResource(ctx, nsID, resourceGroup, req.Resource)
+	return c.WaitIcebergCompactionRunning(ctx, nsID)
 }
 
-func (c *RegionServiceClient) DeleteResourceGroupAwait(ctx context.Context, nsID uuid.UUID, resourceGroup string) error {
+// DisableIcebergCompactionAwait returns nil if the extension is disabled successfully or is not
+// enabled in the first place.
+func (c *RegionServiceClient) DisableIcebergCompactionAwait(ctx context.Context, nsID uuid.UUID) error {
 	if err := c.waitClusterIdle(ctx, nsID); err != nil {
 		return err
 	}
-	res, err := c.mgmtV2Client.DeleteTenantsNsIdResourceGroupsResourceGroupWithResponse(ctx, nsID, resourceGroup)
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdExtensionsIcebergCompactionWithResponse(ctx, nsID)
 	if err != nil {
-		return errors.Wrapf(err, "failed to call API to delete resource group %s", resourceGroup)
+		return errors.Wrap(err, "failed to call API to disable the iceberg compaction extension")
 	}
 	if res.StatusCode() == http.StatusNotFound {
 		return nil
 	}
-	// A rejected deletion (the databases running in the group have to be dropped first) comes
-	// back as 400 with a message that already names them, so pass the body through as is.
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
 		return err
 	}
-	if err := c.waitClusterRescaled(ctx, nsID); err != nil {
-		return err
-	}
 	return wait.Poll(ctx, func() (bool, error) {
-		_, err := c.getResourceGroup(ctx, nsID, resourceGroup)
+		_, err := c.GetIcebergCompaction(ctx, nsID)
 		if err != nil {
-			if errors.Is(err, ErrResourceGroupNotFound) {
+			if errors.Is(err, ErrIcebergCompactionNotFound) {
 				return true, nil
 			}
 			return false, err
 		}
 		return false, nil
-	}, PollingResourceGroupOperation)
+	}, PollingExtensionOperation)
 }
 
-// allowedIamRoles reports the ARNs currently permitted to assume a role into the customer's
-// account, together with the state the platform is in while applying a change.
-func (c *RegionServiceClient) allowedIamRoles(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantAllowedIamRolesResponseBody, error) {
-	res, err := c.mgmtV2Client.GetTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID)
+// GetServerlessBackfill returns ErrServerlessBackfillNotFound unless the extension is enabled.
+func (c *RegionServiceClient) GetServerlessBackfill(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdExtensionsServerlessBackfillingWithResponse(ctx, nsID)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to call API to get the allowed IAM roles")
+		return nil, errors.Wrap(err, "failed to call API to get the serverless backfill extension")
 	}
 	if res.StatusCode() == http.StatusNotFound {
 		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
@@ -692,116 +2355,183 @@ func (c *RegionServiceClient) allowedIamRoles(ctx context.Context, nsID uuid.UUI
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
 		return nil, err
 	}
+	if strings.EqualFold(res.JSON200.Status, ServerlessBackfillStatusDisabled) {
+		return nil, errors.Wrapf(ErrServerlessBackfillNotFound, "cluster %s", nsID)
+	}
 	return res.JSON200, nil
 }
 
-func (c *RegionServiceClient) GetAllowedIamRoles(ctx context.Context, nsID uuid.UUID) ([]string, error) {
-	roles, err := c.allowedIamRoles(ctx, nsID)
+// EnableServerlessBackfill returns as soon as the platform accepts the request, use
+// WaitServerlessBackfillEnabled to wait for the rescale. The serverless backfill extension runs its
+// workers in resource groups of its own, so enabling, resizing or disabling it rescales the
+// cluster: wait for the cluster to be idle first, then for the ExtensionEnabling,
+// ExtensionUpdating or ExtensionDisabling status to go back to running.
+func (c *RegionServiceClient) EnableServerlessBackfill(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.PostTenantsNsIdExtensionsServerlessBackfillingWithResponse(ctx, nsID, req)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "failed to call API to enable the serverless backfill extension")
 	}
-	return roles.RoleArns, nil
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	return apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body))
 }
 
-// pollAllowedIamRoles checks once before polling, since wait.Poll sleeps for a whole interval
-// before its first attempt and every mutation would otherwise pay for it twice.
-func pollAllowedIamRoles(ctx context.Context, check func() (bool, error)) error {
-	if done, err := check(); err != nil || done {
+func (c *RegionServiceClient) WaitServerlessBackfillEnabled(ctx context.Context, nsID uuid.UUID) error {
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
+}
+
+func (c *RegionServiceClient) UpdateServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.TenantExtensionServerlessBackfillRequest) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
 		return err
 	}
-	return wait.Poll(ctx, check, PollingAllowedIamRoleOperation)
+	res, err := c.mgmtV2Client.PutTenantsNsIdExtensionsServerlessBackfillingWithResponse(ctx, nsID, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call API to update the serverless backfill extension")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
+	}
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
 }
 
-// readyAllowedIamRoles reports whether the policy has settled, and gives up on `Failed`:
-// polling on would only spend the whole budget to report a timeout instead of the failure the
-// platform already knows about.
-func (c *RegionServiceClient) readyAllowedIamRoles(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantAllowedIamRolesResponseBody, bool, error) {
-	roles, err := c.allowedIamRoles(ctx, nsID)
+func (c *RegionServiceClient) DisableServerlessBackfillAwait(ctx context.Context, nsID uuid.UUID) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
+		return err
+	}
+	res, err := c.mgmtV2Client.DeleteTenantsNsIdExtensionsServerlessBackfillingWithResponse(ctx, nsID)
 	if err != nil {
-		return nil, false, err
+		return errors.Wrap(err, "failed to call API to disable the serverless backfill extension")
 	}
-	switch roles.Status {
-	case apigen_mgmtv2.GetTenantAllowedIamRolesResponseBodyStatusReady:
-		return roles, true, nil
-	case apigen_mgmtv2.GetTenantAllowedIamRolesResponseBodyStatusFailed:
-		return nil, false, errors.Errorf("the platform failed to apply the allowed IAM roles of cluster %s", nsID)
-	default:
-		return roles, false, nil
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
+		return err
 	}
+	return c.waitClusterOperation(ctx, nsID, PollingExtensionOperation)
 }
 
-// waitAllowedIamRoles waits until the IAM policy can accept another change. The platform
-// answers a request that overlaps another with a 500, so this runs before every mutation.
-func (c *RegionServiceClient) waitAllowedIamRoles(ctx context.Context, nsID uuid.UUID) error {
-	if err := pollAllowedIamRoles(ctx, func() (bool, error) {
-		_, ready, err := c.readyAllowedIamRoles(ctx, nsID)
-		return ready, err
-	}); err != nil {
-		return errors.Wrap(err, "failed to wait for the allowed IAM roles to settle")
+func (c *RegionServiceClient) GetComputeCache(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheResponseBody, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdComputeCacheWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the compute cache")
 	}
-	return nil
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
 }
 
-// waitAllowedIamRoleApplied waits until the list itself shows the change, not merely until the
-// status says the policy has settled.
-//
-// The two are not the same. A check that runs right after a request is accepted can read a
-// status that has not moved yet, and answer with the list as it was. The platform updates the
-// record before the status today, so this is a belt rather than a fix, but membership is the
-// thing the caller actually asked about and it does not depend on that ordering holding.
-func (c *RegionServiceClient) waitAllowedIamRoleApplied(ctx context.Context, nsID uuid.UUID, roleArn string, want bool) error {
-	if err := pollAllowedIamRoles(ctx, func() (bool, error) {
-		roles, ready, err := c.readyAllowedIamRoles(ctx, nsID)
-		if err != nil || !ready {
-			return false, err
-		}
-		return slices.Contains(roles.RoleArns, roleArn) == want, nil
-	}); err != nil {
-		verb := "allowed"
-		if !want {
-			verb = "removed"
-		}
-		return errors.Wrapf(err, "failed to wait for the IAM role %s to be %s", roleArn, verb)
+func (c *RegionServiceClient) GetComputeCacheCapabilities(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdComputeCacheCapabilitiesWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the compute cache capabilities")
 	}
-	return nil
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
 }
 
-func (c *RegionServiceClient) AddAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error {
-	if err := c.waitAllowedIamRoles(ctx, nsID); err != nil {
+// UpdateComputeCacheAwait resizes the compute cache of the compute nodes, which restarts them
+// like a rescale does.
+func (c *RegionServiceClient) UpdateComputeCacheAwait(ctx context.Context, nsID uuid.UUID, req apigen_mgmtv2.PostTenantComputeCacheRequestBody) error {
+	if err := c.waitClusterIdle(ctx, nsID); err != nil {
 		return err
 	}
-	res, err := c.mgmtV2Client.PostTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID, apigen_mgmtv2.TenantAllowedIamRoleRequestBody{
-		RoleArn: roleArn,
-	})
+	res, err := c.mgmtV2Client.PostTenantsNsIdComputeCacheWithResponse(ctx, nsID, req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to call API to allow the IAM role %s", roleArn)
+		return errors.Wrap(err, "failed to call API to update the compute cache")
 	}
-	// the role is already allowed, which is the state the caller asked for
-	if res.StatusCode() == http.StatusConflict {
-		return nil
+	if res.StatusCode() == http.StatusNotFound {
+		return errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
 	}
 	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
 		return err
 	}
-	return c.waitAllowedIamRoleApplied(ctx, nsID, roleArn, true)
+	return c.waitClusterRescaled(ctx, nsID)
 }
 
-func (c *RegionServiceClient) RemoveAllowedIamRoleAwait(ctx context.Context, nsID uuid.UUID, roleArn string) error {
-	if err := c.waitAllowedIamRoles(ctx, nsID); err != nil {
-		return err
+func (c *RegionServiceClient) GetClusterCloudMetadata(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.TenantCloudMetadata, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdCloudMetaWithResponse(ctx, nsID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call API to get the cloud metadata")
 	}
-	res, err := c.mgmtV2Client.DeleteTenantsNsIdAllowedIamRolesWithResponse(ctx, nsID, apigen_mgmtv2.TenantAllowedIamRoleRequestBody{
-		RoleArn: roleArn,
-	})
+	if res.StatusCode() == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
+	}
+	return &res.JSON200.Meta, nil
+}
+
+func (c *RegionServiceClient) GetClusterEndpoint(ctx context.Context, nsID uuid.UUID) (*apigen_mgmtv2.Endpoint, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdEndpointWithResponse(ctx, nsID)
 	if err != nil {
-		return errors.Wrapf(err, "failed to call API to remove the IAM role %s", roleArn)
+		return nil, errors.Wrap(err, "failed to call API to get the endpoint")
 	}
-	// already gone, which is the state the caller asked for
 	if res.StatusCode() == http.StatusNotFound {
-		return nil
+		return nil, errors.Wrapf(ErrClusterNotFound, "endpoint of cluster %s not found", nsID)
 	}
-	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusAccepted, string(res.Body)); err != nil {
-		return err
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return nil, err
 	}
-	return c.waitAllowedIamRoleApplied(ctx, nsID, roleArn, false)
+	return res.JSON200, nil
+}
+
+// GetClusterCACertificate returns the PEM encoded certificate chain the endpoint of the cluster is
+// verified with.
+func (c *RegionServiceClient) GetClusterCACertificate(ctx context.Context, nsID uuid.UUID) (string, error) {
+	res, err := c.mgmtV2Client.GetTenantsNsIdCaCertWithResponse(ctx, nsID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call API to get the CA certificate")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return "", errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return "", err
+	}
+	return string(res.Body), nil
+}
+
+// GetRootCACertificate returns the PEM encoded root CA certificate of the region.
+func (c *RegionServiceClient) GetRootCACertificate(ctx context.Context) (string, error) {
+	res, err := c.mgmtV1Client.GetRootcaWithResponse(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call API to get the root CA certificate")
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return "", err
+	}
+	return string(res.Body), nil
+}
+
+// CreateClusterOAuthToken issues a short-lived OAuth token to connect to the cluster with.
+func (c *RegionServiceClient) CreateClusterOAuthToken(ctx context.Context, nsID uuid.UUID) (string, error) {
+	res, err := c.mgmtV2Client.PostTenantsNsIdOauthTokenWithResponse(ctx, nsID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to call API to create an OAuth token")
+	}
+	if res.StatusCode() == http.StatusNotFound {
+		return "", errors.Wrapf(ErrClusterNotFound, "cluster %s not found", nsID)
+	}
+	if err := apigen.ExpectStatusCodeWithMessage(res, http.StatusOK, string(res.Body)); err != nil {
+		return "", err
+	}
+	return res.JSON200.Token, nil
 }
@@ -3,14 +3,17 @@ package cloudsdk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/wait"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -228,3 +231,890 @@ func TestAllowedIamRoleMutationStopsOnFailedStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBackupSnapshotsPaginates(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+	all := []apigen_mgmtv2.BackupSnapshotItem{
+		{Id: uuid.Must(uuid.NewRandom()), RwSnapshotId: 1},
+		{Id: uuid.Must(uuid.NewRandom()), RwSnapshotId: 2},
+		{Id: uuid.Must(uuid.NewRandom()), RwSnapshotId: 3},
+	}
+
+	client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tenants/"+nsID.String()+"/backups", r.URL.Path)
+
+		// serve two items per page regardless of the requested limit
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		require.NoError(t, err)
+		end := min(offset+2, len(all))
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.BackupSnapshotsPagination{
+			Items:      all[offset:end],
+			Pagination: &apigen_mgmtv2.Pagination{Offset: uint64(offset), Limit: 2, Size: uint64(len(all))},
+		}))
+	}))
+
+	snapshots, err := client.GetBackupSnapshots(context.Background(), nsID)
+	require.NoError(t, err)
+	assert.Equal(t, all, snapshots)
+}
+
+func TestWaitBackupSnapshotCompleted(t *testing.T) {
+	previousPolling := PollingBackupSnapshotCreation
+	PollingBackupSnapshotCreation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingBackupSnapshotCreation = previousPolling
+	})
+
+	tests := []struct {
+		name        string
+		statuses    []string // the status reported by each read, the last one repeats
+		expectErr   string
+		expectReads int
+	}{
+		{
+			name:        "completed",
+			statuses:    []string{"", "CREATING", "COMPLETED"},
+			expectReads: 3,
+		},
+		{
+			name:        "status is compared case-insensitively",
+			statuses:    []string{"completed"},
+			expectReads: 1,
+		},
+		{
+			name:        "failed",
+			statuses:    []string{"CREATING", "FAILED"},
+			expectErr:   "the platform failed to take the snapshot",
+			expectReads: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			snapshotID := uuid.Must(uuid.NewRandom())
+			reads := 0
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+
+				// an empty status stands for a snapshot that is not listed yet
+				items := []apigen_mgmtv2.BackupSnapshotItem{}
+				if status != "" {
+					items = append(items, apigen_mgmtv2.BackupSnapshotItem{Id: snapshotID, Status: status})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.BackupSnapshotsPagination{
+					Items: items,
+				}))
+			}))
+
+			snapshot, err := client.WaitBackupSnapshotCompleted(context.Background(), nsID, snapshotID)
+			assert.Equal(t, tt.expectReads, reads)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, snapshotID, snapshot.Id)
+		})
+	}
+}
+
+func TestInPlaceRestoreClusterAwait(t *testing.T) {
+	previousStart, previousRestore := PollingRescaleStart, PollingInPlaceRestore
+	PollingRescaleStart = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingInPlaceRestore = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingRescaleStart, PollingInPlaceRestore = previousStart, previousRestore
+	})
+
+	tests := []struct {
+		name          string
+		statuses      []apigen_mgmtv2.TenantStatus // the status reported by each read, the last one repeats
+		expectErr     string
+		expectRestore bool
+	}{
+		{
+			name:          "restored",
+			statuses:      []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.Running, apigen_mgmtv2.Restoring, apigen_mgmtv2.Restoring, apigen_mgmtv2.Running},
+			expectRestore: true,
+		},
+		{
+			name:      "cluster is not running",
+			statuses:  []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Updating},
+			expectErr: ErrClusterNotRunning.Error(),
+		},
+		{
+			name:          "restore failed",
+			statuses:      []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.Restoring, apigen_mgmtv2.Failed},
+			expectErr:     "the platform reported the cluster as failed",
+			expectRestore: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			snapshotID := uuid.Must(uuid.NewRandom())
+			reads := 0
+			restored := false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					assert.Equal(t, fmt.Sprintf("/tenants/%s/backups/%s/in-place-restore", nsID, snapshotID), r.URL.Path)
+					restored = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+					NsId:         nsID,
+					Status:       status,
+					HealthStatus: apigen_mgmtv2.Healthy,
+				}))
+			}))
+
+			err := client.InPlaceRestoreClusterAwait(context.Background(), nsID, snapshotID)
+			assert.Equal(t, tt.expectRestore, restored)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.statuses), reads)
+		})
+	}
+}
+
+func TestUpdateIcebergCompactionAwait(t *testing.T) {
+	previousIdle, previousStart, previousExtension := PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation
+	PollingResourceGroupOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingRescaleStart = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingExtensionOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation = previousIdle, previousStart, previousExtension
+	})
+
+	tests := []struct {
+		name      string
+		statuses  []string // the status reported by each read of the extension, the last one repeats, empty for not found
+		expectErr string
+	}{
+		{
+			name:     "updated",
+			statuses: []string{"Running", "Updating", "Updating", "Running"},
+		},
+		{
+			name:     "briefly disabled",
+			statuses: []string{"Running", "Disabled", "Updating", "Running"},
+		},
+		{
+			name:     "briefly not found",
+			statuses: []string{"Running", "", "Updating", "Running"},
+		},
+		{
+			name:      "update failed",
+			statuses:  []string{"Running", "Updating", "Failed"},
+			expectErr: "the platform failed to apply the iceberg compaction extension",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			compactionPath := fmt.Sprintf("/tenants/%s/extensions/iceberg-compaction", nsID)
+			reads := 0
+			updated := false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != compactionPath {
+					w.Header().Set("Content-Type", "application/json")
+					require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+						NsId:         nsID,
+						Status:       apigen_mgmtv2.Running,
+						HealthStatus: apigen_mgmtv2.Healthy,
+					}))
+					return
+				}
+				if r.Method == http.MethodPut {
+					var body apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, 2, body.Resources.Replica)
+					updated = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				require.True(t, updated, "the extension is polled before the update is sent")
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+				if status == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.IcebergCompaction{
+					Status: status,
+				}))
+			}))
+
+			compaction, err := client.UpdateIcebergCompactionAwait(context.Background(), nsID, apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody{
+				Resources: &apigen_mgmtv2.ComponentResourceRequest{
+					ComponentTypeId: "p-2c8g",
+					Replica:         2,
+				},
+			})
+			assert.True(t, updated)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, IcebergCompactionStatusRunning, compaction.Status)
+			assert.Equal(t, len(tt.statuses), reads)
+		})
+	}
+}
+
+func TestEnableServerlessBackfill(t *testing.T) {
+	previousIdle, previousStart, previousExtension := PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation
+	PollingResourceGroupOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingRescaleStart = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingExtensionOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation = previousIdle, previousStart, previousExtension
+	})
+
+	tests := []struct {
+		name         string
+		statuses     []apigen_mgmtv2.TenantStatus // the status reported by each read, the last one repeats
+		expectErr    string
+		expectEnable bool
+	}{
+		{
+			name:         "enabled",
+			statuses:     []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.Running, apigen_mgmtv2.ExtensionEnabling, apigen_mgmtv2.ExtensionEnabling, apigen_mgmtv2.Running},
+			expectEnable: true,
+		},
+		{
+			name:         "waits for an ongoing rescale first",
+			statuses:     []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Updating, apigen_mgmtv2.Running, apigen_mgmtv2.ExtensionEnabling, apigen_mgmtv2.Running},
+			expectEnable: true,
+		},
+		{
+			name:         "enabling failed",
+			statuses:     []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.ExtensionEnabling, apigen_mgmtv2.Failed},
+			expectErr:    "the platform reported the cluster as failed",
+			expectEnable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			reads := 0
+			enabled := false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					assert.Equal(t, fmt.Sprintf("/tenants/%s/extensions/serverless-backfilling", nsID), r.URL.Path)
+					assert.Equal(t, apigen_mgmtv2.Running, tt.statuses[reads-1], "the extension is enabled before the cluster is idle")
+					enabled = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+					NsId:         nsID,
+					Status:       status,
+					HealthStatus: apigen_mgmtv2.Healthy,
+				}))
+			}))
+
+			err := client.EnableServerlessBackfill(context.Background(), nsID, apigen_mgmtv2.TenantExtensionServerlessBackfillRequest{
+				Resources: apigen_mgmtv2.ComponentResourceRequest{
+					ComponentTypeId: "p-2c8g",
+					Replica:         2,
+				},
+			})
+			if err == nil {
+				err = client.WaitServerlessBackfillEnabled(context.Background(), nsID)
+			}
+			assert.Equal(t, tt.expectEnable, enabled)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.statuses), reads)
+		})
+	}
+}
+
+func TestServerlessCompactionWaitsForIdleCluster(t *testing.T) {
+	previousIdle, previousStart, previousExtension := PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation
+	PollingResourceGroupOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingRescaleStart = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingExtensionOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingResourceGroupOperation, PollingRescaleStart, PollingExtensionOperation = previousIdle, previousStart, previousExtension
+	})
+
+	req := apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 8}
+	tests := []struct {
+		name       string
+		method     string
+		transition apigen_mgmtv2.TenantStatus
+		call       func(client *RegionServiceClient, nsID uuid.UUID) error
+	}{
+		{
+			name:       "enable",
+			method:     http.MethodPost,
+			transition: apigen_mgmtv2.ExtensionEnabling,
+			call: func(client *RegionServiceClient, nsID uuid.UUID) error {
+				return client.EnableServerlessCompactionAwait(context.Background(), nsID, req)
+			},
+		},
+		{
+			name:       "update",
+			method:     http.MethodPut,
+			transition: apigen_mgmtv2.ExtensionUpdating,
+			call: func(client *RegionServiceClient, nsID uuid.UUID) error {
+				return client.UpdateServerlessCompactionAwait(context.Background(), nsID, req)
+			},
+		},
+		{
+			name:       "disable",
+			method:     http.MethodDelete,
+			transition: apigen_mgmtv2.ExtensionDisabling,
+			call: func(client *RegionServiceClient, nsID uuid.UUID) error {
+				return client.DisableServerlessCompactionAwait(context.Background(), nsID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			// an earlier rescale is still running when the request is made
+			statuses := []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Updating, apigen_mgmtv2.Updating, apigen_mgmtv2.Running, tt.transition, apigen_mgmtv2.Running}
+			reads := 0
+			sent := false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == tt.method {
+					assert.Equal(t, fmt.Sprintf("/tenants/%s/extensions/compaction", nsID), r.URL.Path)
+					assert.Equal(t, apigen_mgmtv2.Running, statuses[reads-1], "the extension is changed before the cluster is idle")
+					sent = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				status := statuses[min(reads, len(statuses)-1)]
+				reads++
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+					NsId:         nsID,
+					Status:       status,
+					HealthStatus: apigen_mgmtv2.Healthy,
+				}))
+			}))
+
+			require.NoError(t, tt.call(client, nsID))
+			assert.True(t, sent)
+			assert.Equal(t, len(statuses), reads)
+		})
+	}
+}
+
+func TestStopClusterAwait(t *testing.T) {
+	previousIdle, previousPower := PollingResourceGroupOperation, PollingClusterPowerOperation
+	PollingResourceGroupOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingClusterPowerOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingResourceGroupOperation, PollingClusterPowerOperation = previousIdle, previousPower
+	})
+
+	tests := []struct {
+		name       string
+		statuses   []apigen_mgmtv2.TenantStatus // the status reported by each read, the last one repeats
+		expectErr  string
+		expectStop bool
+	}{
+		{
+			name:       "stopped",
+			statuses:   []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.Stopping, apigen_mgmtv2.Stopping, apigen_mgmtv2.Stopped},
+			expectStop: true,
+		},
+		{
+			name:       "waits for an ongoing rescale first",
+			statuses:   []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Updating, apigen_mgmtv2.Running, apigen_mgmtv2.Stopping, apigen_mgmtv2.Stopped},
+			expectStop: true,
+		},
+		{
+			name:       "stopping failed",
+			statuses:   []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Running, apigen_mgmtv2.Stopping, apigen_mgmtv2.Failed},
+			expectErr:  "the platform reported the cluster as failed",
+			expectStop: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			reads := 0
+			stopped := false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					assert.Equal(t, fmt.Sprintf("/tenants/%s/stop", nsID), r.URL.Path)
+					assert.Equal(t, apigen_mgmtv2.Running, tt.statuses[reads-1], "the cluster is stopped before it is idle")
+					stopped = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+					NsId:         nsID,
+					Status:       status,
+					HealthStatus: apigen_mgmtv2.Healthy,
+				}))
+			}))
+
+			err := client.StopClusterAwait(context.Background(), nsID)
+			assert.Equal(t, tt.expectStop, stopped)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.statuses), reads)
+		})
+	}
+}
+
+func TestRestartClusterAwait(t *testing.T) {
+	previousIdle, previousStart, previousCreation := PollingResourceGroupOperation, PollingRescaleStart, PollingTenantCreation
+	PollingResourceGroupOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingRescaleStart = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	PollingTenantCreation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingResourceGroupOperation, PollingRescaleStart, PollingTenantCreation = previousIdle, previousStart, previousCreation
+	})
+
+	nsID := uuid.Must(uuid.NewRandom())
+	// the cluster stays healthy for a while after the restart is accepted
+	healths := []apigen_mgmtv2.TenantHealthStatus{
+		apigen_mgmtv2.Healthy,
+		apigen_mgmtv2.Healthy,
+		apigen_mgmtv2.Unhealthy,
+		apigen_mgmtv2.Unhealthy,
+		apigen_mgmtv2.Healthy,
+	}
+	reads := 0
+	restarted := false
+
+	client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			assert.Equal(t, fmt.Sprintf("/tenants/%s/restart", nsID), r.URL.Path)
+			restarted = true
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		health := healths[min(reads, len(healths)-1)]
+		reads++
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+			NsId:         nsID,
+			Status:       apigen_mgmtv2.Running,
+			HealthStatus: health,
+		}))
+	}))
+
+	require.NoError(t, client.RestartClusterAwait(context.Background(), nsID))
+	assert.True(t, restarted)
+	assert.Equal(t, len(healths), reads, "the wait returned before the restart was done")
+}
+
+func TestWaitMatViewBackfilled(t *testing.T) {
+	previousPolling := PollingMatViewBackfill
+	PollingMatViewBackfill = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingMatViewBackfill = previousPolling
+	})
+
+	tests := []struct {
+		name        string
+		progress    []string // the progress reported by each read, the last one repeats
+		timeout     time.Duration
+		expectErr   string
+		expectReads int
+	}{
+		{
+			name:        "completed",
+			progress:    []string{"10%", "60%", "Completed"},
+			timeout:     time.Second,
+			expectReads: 3,
+		},
+		{
+			name:        "no longer tracked",
+			progress:    []string{"10%", ""},
+			timeout:     time.Second,
+			expectReads: 2,
+		},
+		{
+			name:      "timeout",
+			progress:  []string{"10%"},
+			timeout:   20 * time.Millisecond,
+			expectErr: "last progress is 10%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsID := uuid.Must(uuid.NewRandom())
+			reads := 0
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, fmt.Sprintf("/tenants/%s/databases/dev/matviews/revenue/progress", nsID), r.URL.Path)
+				progress := tt.progress[min(reads, len(tt.progress)-1)]
+				reads++
+
+				// an empty progress stands for a materialized view whose backfill is not tracked
+				if progress == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				body := apigen_mgmtv2.TenantDdlProgress{Progress: progress, Status: apigen_mgmtv2.InProgress}
+				if progress == "Completed" {
+					body = apigen_mgmtv2.TenantDdlProgress{Progress: "100%", Status: apigen_mgmtv2.Completed}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(body))
+			}))
+
+			err := client.WaitMatViewBackfilled(context.Background(), nsID, "dev", "revenue", tt.timeout)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, wait.ErrWaitTimeout)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectReads, reads)
+		})
+	}
+}
+
+func TestGetSourceAndSinkDownstreams(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name string
+		path string
+		call func(client *RegionServiceClient) ([]apigen_mgmtv2.RwDependency, error)
+	}{
+		{
+			name: "source",
+			path: fmt.Sprintf("/tenants/%s/databases/dev/sources/orders/dependencies", nsID),
+			call: func(client *RegionServiceClient) ([]apigen_mgmtv2.RwDependency, error) {
+				return client.GetSourceDownstreams(context.Background(), nsID, "dev", "orders")
+			},
+		},
+		{
+			name: "sink",
+			path: fmt.Sprintf("/tenants/%s/databases/dev/sinks/orders_sink/dependencies", nsID),
+			call: func(client *RegionServiceClient) ([]apigen_mgmtv2.RwDependency, error) {
+				return client.GetSinkDownstreams(context.Background(), nsID, "dev", "orders_sink")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.path, r.URL.Path)
+				assert.Equal(t, "limit=50&offset=0&type=downstream", r.URL.RawQuery)
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.RwDependencyPagination{
+					Dependencies: []apigen_mgmtv2.RwDependency{{Name: "revenue", Schema: "public", Type: "MATERIALIZED VIEW"}},
+					Pagination:   &apigen_mgmtv2.Pagination{Limit: 50, Size: 1},
+				}))
+			}))
+
+			dependencies, err := tt.call(client)
+			require.NoError(t, err)
+			require.Len(t, dependencies, 1)
+			assert.Equal(t, "revenue", dependencies[0].Name)
+		})
+	}
+}
+
+func TestPingSource(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		status      int
+		body        interface{}
+		expectError string
+		unreachable bool
+	}{
+		{
+			name:   "available",
+			status: http.StatusOK,
+			body:   apigen_mgmtv2.AvailabilityResponse{Available: true},
+		},
+		{
+			name:        "unavailable",
+			status:      http.StatusOK,
+			body:        apigen_mgmtv2.AvailabilityResponse{Msg: "dial tcp 10.0.0.1:9092: i/o timeout"},
+			expectError: "i/o timeout",
+			unreachable: true,
+		},
+		{
+			name:        "bad request",
+			status:      http.StatusBadRequest,
+			body:        apigen_mgmtv2.BadRequestResponse{Msg: "server is required"},
+			expectError: "server is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, fmt.Sprintf("/tenants/%s/sources/ping", nsID), r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				require.NoError(t, json.NewEncoder(w).Encode(tt.body))
+			}))
+
+			req := apigen_mgmtv2.PostSourcesPingRequestBody{Type: apigen_mgmtv2.Kafka}
+			require.NoError(t, req.Config.FromKafkaConfig(apigen_mgmtv2.KafkaConfig{Server: "10.0.0.1:9092"}))
+			err := client.PingSource(context.Background(), nsID, req)
+			if tt.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectError)
+			assert.Equal(t, tt.unreachable, errors.Is(err, ErrSourceUnreachable))
+		})
+	}
+}
+
+func TestDeleteBYOCClusterAwait(t *testing.T) {
+	previous := PollingBYOCClusterOperation
+	PollingBYOCClusterOperation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingBYOCClusterOperation = previous
+	})
+
+	tests := []struct {
+		name            string
+		statuses        []apigen_mgmtv2.ClusterStatus // the status reported by each read, the last one repeats
+		expectErr       string
+		expectTerminate bool
+		expectDelete    bool
+	}{
+		{
+			name:            "terminated and deleted",
+			statuses:        []apigen_mgmtv2.ClusterStatus{apigen_mgmtv2.ClusterStatusReady, apigen_mgmtv2.ClusterStatusTerminating, apigen_mgmtv2.ClusterStatusDeleted},
+			expectTerminate: true,
+			expectDelete:    true,
+		},
+		{
+			name:         "already terminating",
+			statuses:     []apigen_mgmtv2.ClusterStatus{apigen_mgmtv2.ClusterStatusTerminating, apigen_mgmtv2.ClusterStatusDeleted},
+			expectDelete: true,
+		},
+		{
+			name:            "termination failed",
+			statuses:        []apigen_mgmtv2.ClusterStatus{apigen_mgmtv2.ClusterStatusReady, apigen_mgmtv2.ClusterStatusTerminating, apigen_mgmtv2.ClusterStatusFailed},
+			expectErr:       "failed to terminate BYOC cluster prod",
+			expectTerminate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reads := 0
+			terminated, deleted := false, false
+
+			client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					assert.Equal(t, "/byoc-clusters/prod/terminate", r.URL.Path)
+					terminated = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				case http.MethodDelete:
+					assert.Equal(t, "/byoc-clusters/prod", r.URL.Path)
+					assert.True(t, terminated || !tt.expectTerminate, "the cluster is deleted before it is terminated")
+					deleted = true
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				status := tt.statuses[min(reads, len(tt.statuses)-1)]
+				reads++
+
+				w.Header().Set("Content-Type", "application/json")
+				require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.ManagedCluster{
+					Name:   "prod",
+					Status: status,
+				}))
+			}))
+
+			err := client.DeleteBYOCClusterAwait(context.Background(), "prod")
+			assert.Equal(t, tt.expectTerminate, terminated)
+			assert.Equal(t, tt.expectDelete, deleted)
+			if tt.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateClusterAwaitingConfig(t *testing.T) {
+	previous := PollingTenantCreation
+	PollingTenantCreation = wait.PollingParams{
+		Timeout:  time.Second,
+		Interval: time.Millisecond,
+	}
+	t.Cleanup(func() {
+		PollingTenantCreation = previous
+	})
+
+	nsID := uuid.Must(uuid.NewRandom())
+	statuses := []apigen_mgmtv2.TenantStatus{apigen_mgmtv2.Creating, apigen_mgmtv2.AwaitingConfig}
+	reads := 0
+
+	client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			assert.Equal(t, "/tenants", r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+			require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{NsId: nsID, Status: apigen_mgmtv2.Creating}))
+			return
+		}
+		assert.Equal(t, "/tenants/"+nsID.String(), r.URL.Path)
+		status := statuses[min(reads, len(statuses)-1)]
+		reads++
+		require.NoError(t, json.NewEncoder(w).Encode(apigen_mgmtv2.Tenant{
+			NsId:               nsID,
+			Status:             status,
+			ServiceAccountName: ptr.Ptr("risingwave-sa"),
+		}))
+	}))
+
+	// the cluster is returned once it awaits its configuration, it never gets to running here
+	cluster, err := client.CreateClusterAwaitingConfig(context.Background(), apigen_mgmtv2.TenantRequestRequestBody{
+		TenantName: "test-cluster",
+		Tier:       ptr.Ptr(apigen_mgmtv2.TierIdBYOK),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, apigen_mgmtv2.AwaitingConfig, cluster.Status)
+	assert.Equal(t, "risingwave-sa", *cluster.ServiceAccountName)
+}
+
+func TestGetClusterCACertificate(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+	chain := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	client := newTestRegionServiceClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tenants/"+nsID.String()+"/caCert" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		_, _ = w.Write([]byte(chain))
+	}))
+
+	got, err := client.GetClusterCACertificate(context.Background(), nsID)
+	require.NoError(t, err)
+	assert.Equal(t, chain, got)
+
+	_, err = client.GetClusterCACertificate(context.Background(), uuid.Must(uuid.NewRandom()))
+	assert.True(t, errors.Is(err, ErrClusterNotFound))
+}
@@ -0,0 +1,132 @@
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestClusterBackupResource covers taking a snapshot, importing it, and deleting it.
+func TestClusterBackupResource(t *testing.T) {
+	clusterName := fmt.Sprintf("tf%sbak", getTestNamespace(t))
+
+	config := testResourceGroupCluster(clusterName, 1) + `
+resource "risingwavecloud_cluster_backup" "test" {
+	cluster_id = risingwavecloud_cluster.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"risingwavecloud_cluster_backup.test", "cluster_id",
+						"risingwavecloud_cluster.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("risingwavecloud_cluster_backup.test", "snapshot_id"),
+					resource.TestCheckResourceAttrSet("risingwavecloud_cluster_backup.test", "rw_snapshot_id"),
+					resource.TestCheckResourceAttrSet("risingwavecloud_cluster_backup.test", "created_at"),
+					resource.TestCheckResourceAttrPair(
+						"risingwavecloud_cluster_backup.test", "rw_version",
+						"risingwavecloud_cluster.test", "version",
+					),
+				),
+			},
+			// Import: the id is [cluster ID].[snapshot ID]
+			{
+				Config:            config,
+				ResourceName:      "risingwavecloud_cluster_backup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// TestClusterResource_RestoreFromBackup restores a snapshot of a cluster into a new one, which
+// inherits the tier of the source cluster.
+func TestClusterResource_RestoreFromBackup(t *testing.T) {
+	clusterName := fmt.Sprintf("tf%sbak", getTestNamespace(t))
+
+	config := testResourceGroupCluster(clusterName, 1) + fmt.Sprintf(`
+resource "risingwavecloud_cluster_backup" "test" {
+	cluster_id = risingwavecloud_cluster.test.id
+}
+
+resource "risingwavecloud_cluster" "restored" {
+	region   = risingwavecloud_cluster.test.region
+	name     = "%s-restored"
+	version  = "%s"
+	restore_from = {
+		cluster_id  = risingwavecloud_cluster.test.id
+		snapshot_id = risingwavecloud_cluster_backup.test.snapshot_id
+	}
+	spec = risingwavecloud_cluster.test.spec
+}
+`, clusterName, testResourceGroupVersion)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("risingwavecloud_cluster.restored", "id"),
+					resource.TestCheckResourceAttr("risingwavecloud_cluster.restored", "version", testResourceGroupVersion),
+					resource.TestCheckResourceAttrPair(
+						"risingwavecloud_cluster.restored", "tier",
+						"risingwavecloud_cluster.test", "tier",
+					),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// TestClusterInPlaceRestoreResource rolls a cluster back to a snapshot of itself.
+func TestClusterInPlaceRestoreResource(t *testing.T) {
+	clusterName := fmt.Sprintf("tf%sipr", getTestNamespace(t))
+
+	config := testResourceGroupCluster(clusterName, 1) + `
+resource "risingwavecloud_cluster_backup" "test" {
+	cluster_id = risingwavecloud_cluster.test.id
+}
+
+resource "risingwavecloud_cluster_in_place_restore" "test" {
+	cluster_id        = risingwavecloud_cluster_backup.test.cluster_id
+	snapshot_id       = risingwavecloud_cluster_backup.test.snapshot_id
+	confirm_data_loss = true
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"risingwavecloud_cluster_in_place_restore.test", "snapshot_id",
+						"risingwavecloud_cluster_backup.test", "snapshot_id",
+					),
+					resource.TestCheckResourceAttrSet("risingwavecloud_cluster_in_place_restore.test", "restored_at"),
+				),
+			},
+			// the restore is not repeated while the configuration is unchanged
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConnectorModel is the part of the source and sink resources that configures their connector.
+// It is embedded in their models.
+type ConnectorModel struct {
+	Connector           types.String `tfsdk:"connector"`
+	Properties          types.Map    `tfsdk:"properties"`
+	SensitiveProperties types.Map    `tfsdk:"sensitive_properties"`
+	SecretProperties    types.Map    `tfsdk:"secret_properties"`
+	Format              types.String `tfsdk:"format"`
+	Encode              types.String `tfsdk:"encode"`
+	EncodeProperties    types.Map    `tfsdk:"encode_properties"`
+}
+
+// connectorPropertyKeyPattern is what the keys of the WITH clause look like, e.g.
+// `properties.bootstrap.server`. They are written into the statement as they are, so anything
+// else is rejected.
+var connectorPropertyKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.\-]*$`)
+
+// connectorSchemaAttributes returns the attributes of ConnectorModel. kind is "source" or "sink".
+func connectorSchemaAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"connector": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The connector of the %s, e.g. `kafka` or `postgres-cdc`.", kind),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"properties": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("The properties of the connector, i.e. the `WITH` clause of the %s without "+
+				"`connector`. They are read back from the platform, so a property changed outside of Terraform shows up "+
+				"in the plan.", kind),
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"sensitive_properties": schema.MapAttribute{
+			MarkdownDescription: "Properties of the connector that are hidden in the plan output, e.g. passwords. They are " +
+				"still stored in the state, and are not read back from the platform. Prefer `secret_properties`.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"secret_properties": schema.MapAttribute{
+			MarkdownDescription: "Properties of the connector whose value is a secret, by the name of the secret, e.g. " +
+				"the `name` of a `risingwavecloud_secret` resource. They are written as `key = SECRET name`, so the " +
+				"values never leave the cluster.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "The `FORMAT` of the data, e.g. `PLAIN`, `UPSERT` or `DEBEZIUM`. Must be set together with `encode`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"encode": schema.StringAttribute{
+			MarkdownDescription: "The `ENCODE` of the data, e.g. `JSON`, `AVRO` or `PROTOBUF`. Must be set together with `format`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"encode_properties": schema.MapAttribute{
+			MarkdownDescription: "The options of the `ENCODE` clause, e.g. `schema.registry`.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// validateConnectorConfig checks what ends up in the statement as it is. Unknown values are
+// checked when they are known.
+func validateConnectorConfig(ctx context.Context, data ConnectorModel, diags *diag.Diagnostics) {
+	seen := map[string]string{}
+	for attrName, m := range map[string]types.Map{
+		"properties":           data.Properties,
+		"sensitive_properties": data.SensitiveProperties,
+		"secret_properties":    data.SecretProperties,
+	} {
+		for key := range m.Elements() {
+			if !connectorPropertyKeyPattern.MatchString(key) {
+				diags.AddAttributeError(path.Root(attrName), "Invalid property key", fmt.Sprintf("%q is not a valid property key", key))
+			}
+			if strings.EqualFold(key, "connector") {
+				diags.AddAttributeError(path.Root(attrName), "Invalid property key", "The connector is set with the \"connector\" attribute")
+			}
+			if other, ok := seen[key]; ok {
+				diags.AddAttributeError(path.Root(attrName), "Duplicate property key", fmt.Sprintf("%q is also set in %s", key, other))
+			}
+			seen[key] = attrName
+		}
+	}
+	for key := range data.EncodeProperties.Elements() {
+		if !connectorPropertyKeyPattern.MatchString(key) {
+			diags.AddAttributeError(path.Root("encode_properties"), "Invalid property key", fmt.Sprintf("%q is not a valid property key", key))
+		}
+	}
+
+	if !data.Format.IsUnknown() && !data.Encode.IsUnknown() && data.Format.IsNull() != data.Encode.IsNull() {
+		diags.AddAttributeError(path.Root("format"), "Incomplete format", "\"format\" and \"encode\" must be set together")
+	}
+	if !data.EncodeProperties.IsNull() && data.Encode.IsNull() {
+		diags.AddAttributeError(path.Root("encode_properties"), "Missing encode", "\"encode_properties\" requires \"encode\"")
+	}
+}
+
+// quoteLiteral quotes a SQL string literal.
+func quoteLiteral(value string) string {
+	return `'` + strings.ReplaceAll(value, `'`, `''`) + `'`
+}
+
+// sortedStringMap reads a map attribute, and returns it along with its keys in order so that the
+// same configuration always renders the same statement.
+func sortedStringMap(ctx context.Context, m types.Map, diags *diag.Diagnostics) (map[string]string, []string) {
+	values := map[string]string{}
+	if m.IsNull() || m.IsUnknown() {
+		return values, nil
+	}
+	diags.Append(m.ElementsAs(ctx, &values, false)...)
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return values, keys
+}
+
+// connectorClauses renders the `WITH` clause and, if a format is set, the `FORMAT ... ENCODE ...`
+// clause of a source or a sink.
+func connectorClauses(ctx context.Context, data ConnectorModel, diags *diag.Diagnostics) string {
+	options := []string{"connector = " + quoteLiteral(data.Connector.ValueString())}
+
+	properties, keys := sortedStringMap(ctx, data.Properties, diags)
+	for _, k := range keys {
+		options = append(options, fmt.Sprintf("%s = %s", k, quoteLiteral(properties[k])))
+	}
+	sensitive, keys := sortedStringMap(ctx, data.SensitiveProperties, diags)
+	for _, k := range keys {
+		options = append(options, fmt.Sprintf("%s = %s", k, quoteLiteral(sensitive[k])))
+	}
+	secrets, keys := sortedStringMap(ctx, data.SecretProperties, diags)
+	for _, k := range keys {
+		options = append(options, fmt.Sprintf("%s = SECRET %s", k, quoteIdentifier(secrets[k])))
+	}
+
+	clauses := fmt.Sprintf("WITH (%s)", strings.Join(options, ", "))
+
+	if !data.Format.IsNull() {
+		clauses += fmt.Sprintf(" FORMAT %s ENCODE %s", data.Format.ValueString(), data.Encode.ValueString())
+		encodeProperties, keys := sortedStringMap(ctx, data.EncodeProperties, diags)
+		if len(keys) > 0 {
+			encodeOptions := make([]string, 0, len(keys))
+			for _, k := range keys {
+				encodeOptions = append(encodeOptions, fmt.Sprintf("%s = %s", k, quoteLiteral(encodeProperties[k])))
+			}
+			clauses += fmt.Sprintf(" (%s)", strings.Join(encodeOptions, ", "))
+		}
+	}
+	return clauses
+}
+
+// connectorToDataModel updates the connector and the properties from what the platform reports.
+// Only the configured properties are compared: the platform also reports the defaults of the
+// properties that are not set, and may leave out the ones it considers sensitive. A property the
+// platform does not report keeps its configured value.
+func connectorToDataModel(connectorType string, properties map[string]string, data *ConnectorModel) {
+	if connectorType != "" && !strings.EqualFold(connectorType, data.Connector.ValueString()) {
+		data.Connector = types.StringValue(strings.ToLower(connectorType))
+	}
+
+	// imported, every reported property is taken.
+	if data.Properties.IsNull() && data.SensitiveProperties.IsNull() && data.SecretProperties.IsNull() {
+		elements := map[string]attr.Value{}
+		for k, v := range properties {
+			if !strings.EqualFold(k, "connector") {
+				elements[k] = types.StringValue(v)
+			}
+		}
+		if len(elements) > 0 {
+			data.Properties = types.MapValueMust(types.StringType, elements)
+		}
+		return
+	}
+
+	if data.Properties.IsNull() || data.Properties.IsUnknown() {
+		return
+	}
+	elements := map[string]attr.Value{}
+	for k, v := range data.Properties.Elements() {
+		elements[k] = v
+		if reported, ok := properties[k]; ok {
+			elements[k] = types.StringValue(reported)
+		}
+	}
+	data.Properties = types.MapValueMust(types.StringType, elements)
+}
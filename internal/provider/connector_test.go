@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringMap(m map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func nullConnectorModel() ConnectorModel {
+	return ConnectorModel{
+		Connector:           types.StringNull(),
+		Properties:          types.MapNull(types.StringType),
+		SensitiveProperties: types.MapNull(types.StringType),
+		SecretProperties:    types.MapNull(types.StringType),
+		Format:              types.StringNull(),
+		Encode:              types.StringNull(),
+		EncodeProperties:    types.MapNull(types.StringType),
+	}
+}
+
+func TestConnectorClauses(t *testing.T) {
+	ctx := context.Background()
+
+	data := nullConnectorModel()
+	data.Connector = types.StringValue("kafka")
+	data.Properties = stringMap(map[string]string{
+		"topic":                       "orders",
+		"properties.bootstrap.server": "broker-1:9092",
+	})
+	data.SensitiveProperties = stringMap(map[string]string{"properties.sasl.username": "o'brien"})
+	data.SecretProperties = stringMap(map[string]string{"properties.sasl.password": "kafka_password"})
+
+	var diags diag.Diagnostics
+	assert.Equal(t,
+		"WITH (connector = 'kafka', properties.bootstrap.server = 'broker-1:9092', topic = 'orders', "+
+			"properties.sasl.username = 'o''brien', properties.sasl.password = SECRET \"kafka_password\")",
+		connectorClauses(ctx, data, &diags),
+	)
+	require.False(t, diags.HasError(), diags)
+
+	data.Format = types.StringValue("PLAIN")
+	data.Encode = types.StringValue("AVRO")
+	data.EncodeProperties = stringMap(map[string]string{"schema.registry": "http://registry:8081"})
+	assert.Contains(t, connectorClauses(ctx, data, &diags), ") FORMAT PLAIN ENCODE AVRO (schema.registry = 'http://registry:8081')")
+	require.False(t, diags.HasError(), diags)
+}
+
+func TestValidateConnectorConfig(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		update      func(data *ConnectorModel)
+		expectError bool
+	}{
+		{
+			name:   "valid",
+			update: func(data *ConnectorModel) {},
+		},
+		{
+			name: "key with spaces",
+			update: func(data *ConnectorModel) {
+				data.Properties = stringMap(map[string]string{"topic = 'x', foo": "bar"})
+			},
+			expectError: true,
+		},
+		{
+			name: "connector as a property",
+			update: func(data *ConnectorModel) {
+				data.Properties = stringMap(map[string]string{"connector": "kafka"})
+			},
+			expectError: true,
+		},
+		{
+			name: "key set twice",
+			update: func(data *ConnectorModel) {
+				data.SecretProperties = stringMap(map[string]string{"topic": "topic_secret"})
+			},
+			expectError: true,
+		},
+		{
+			name: "format without encode",
+			update: func(data *ConnectorModel) {
+				data.Encode = types.StringNull()
+			},
+			expectError: true,
+		},
+		{
+			name: "encode properties without encode",
+			update: func(data *ConnectorModel) {
+				data.Format = types.StringNull()
+				data.Encode = types.StringNull()
+				data.EncodeProperties = stringMap(map[string]string{"schema.registry": "http://registry:8081"})
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := nullConnectorModel()
+			data.Connector = types.StringValue("kafka")
+			data.Properties = stringMap(map[string]string{"topic": "orders"})
+			data.Format = types.StringValue("PLAIN")
+			data.Encode = types.StringValue("JSON")
+			tt.update(&data)
+
+			var diags diag.Diagnostics
+			validateConnectorConfig(ctx, data, &diags)
+			assert.Equal(t, tt.expectError, diags.HasError(), diags)
+		})
+	}
+}
+
+func TestConnectorToDataModel(t *testing.T) {
+	reported := map[string]string{
+		"connector":                   "kafka",
+		"topic":                       "orders_v2",
+		"properties.bootstrap.server": "broker-1:9092",
+		"scan.startup.mode":           "earliest",
+	}
+
+	// only the configured properties are compared, the defaults are left out.
+	data := nullConnectorModel()
+	data.Connector = types.StringValue("kafka")
+	data.Properties = stringMap(map[string]string{"topic": "orders", "properties.bootstrap.server": "broker-1:9092"})
+	data.SecretProperties = stringMap(map[string]string{"properties.sasl.password": "kafka_password"})
+	connectorToDataModel("KAFKA", reported, &data)
+	assert.Equal(t, "kafka", data.Connector.ValueString())
+	assert.Equal(t, stringMap(map[string]string{"topic": "orders_v2", "properties.bootstrap.server": "broker-1:9092"}), data.Properties)
+	assert.Equal(t, stringMap(map[string]string{"properties.sasl.password": "kafka_password"}), data.SecretProperties)
+
+	// imported, every reported property is taken but the connector.
+	data = nullConnectorModel()
+	connectorToDataModel("kafka", reported, &data)
+	assert.Equal(t, "kafka", data.Connector.ValueString())
+	assert.Equal(t, stringMap(map[string]string{
+		"topic":                       "orders_v2",
+		"properties.bootstrap.server": "broker-1:9092",
+		"scan.startup.mode":           "earliest",
+	}), data.Properties)
+}
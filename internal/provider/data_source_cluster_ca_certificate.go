@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterCACertificateDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ClusterCACertificateDataSource{}
+
+func NewClusterCACertificateDataSource() datasource.DataSource {
+	return &ClusterCACertificateDataSource{}
+}
+
+// ClusterCACertificateDataSource reads the certificate chain clients verify the endpoint of a
+// cluster with, either the one of the cluster or the root CA of a region.
+type ClusterCACertificateDataSource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterCACertificateModel struct {
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	Region            types.String `tfsdk:"region"`
+	PEM               types.String `tfsdk:"pem"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	Certificates      types.List   `tfsdk:"certificates"`
+}
+
+type CACertificateModel struct {
+	Subject           types.String `tfsdk:"subject"`
+	Issuer            types.String `tfsdk:"issuer"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+}
+
+var caCertificateAttrTypes = map[string]attr.Type{
+	"subject":            types.StringType,
+	"issuer":             types.StringType,
+	"fingerprint_sha256": types.StringType,
+	"not_before":         types.StringType,
+	"not_after":          types.StringType,
+}
+
+func (d *ClusterCACertificateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ca_certificate"
+}
+
+func (d *ClusterCACertificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The CA certificate chain to verify the endpoint of a RisingWave cluster with.",
+		MarkdownDescription: clusterCACertificateMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster to get the certificate chain of. " +
+					"Exactly one of `cluster_id` and `region` must be set.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to get the root CA certificate of. Exactly one of `cluster_id` and `region` must be set.",
+				Optional:            true,
+			},
+			"pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded certificate chain, e.g. to pass as `sslrootcert` to a Postgres client.",
+				Computed:            true,
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 fingerprint of the first certificate in `pem`, as lowercase hex.",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The earliest expiry of the certificates in `pem`, in RFC 3339 format. " +
+					"The chain stops verifying then unless the platform rotates it before.",
+				Computed: true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				MarkdownDescription: "The certificates in `pem`, in order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "The subject of the certificate.",
+							Computed:            true,
+						},
+						"issuer": schema.StringAttribute{
+							MarkdownDescription: "The issuer of the certificate.",
+							Computed:            true,
+						},
+						"fingerprint_sha256": schema.StringAttribute{
+							MarkdownDescription: "The SHA-256 fingerprint of the certificate, as lowercase hex.",
+							Computed:            true,
+						},
+						"not_before": schema.StringAttribute{
+							MarkdownDescription: "The time the certificate is valid from, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"not_after": schema.StringAttribute{
+							MarkdownDescription: "The time the certificate expires, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterCACertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterCACertificateDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ClusterCACertificateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ClusterID.IsNull() == data.Region.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cluster_id"),
+			"Invalid certificate source",
+			"Exactly one of cluster_id and region must be set",
+		)
+	}
+}
+
+func (d *ClusterCACertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterCACertificateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		pemData string
+		err     error
+	)
+	if !data.ClusterID.IsNull() {
+		nsID, parseErr := uuid.Parse(data.ClusterID.ValueString())
+		if parseErr != nil {
+			resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+			return
+		}
+		pemData, err = d.client.GetClusterCACertificate(ctx, nsID)
+	} else {
+		pemData, err = d.client.GetRootCACertificate(ctx, data.Region.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the CA certificate", err.Error())
+		return
+	}
+
+	certs, err := parsePEMCertificates(pemData)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse the CA certificate", err.Error())
+		return
+	}
+
+	models := make([]CACertificateModel, 0, len(certs))
+	notAfter := certs[0].NotAfter
+	for _, cert := range certs {
+		if cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+		models = append(models, CACertificateModel{
+			Subject:           types.StringValue(cert.Subject.String()),
+			Issuer:            types.StringValue(cert.Issuer.String()),
+			FingerprintSHA256: types.StringValue(certificateFingerprint(cert)),
+			NotBefore:         types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339)),
+			NotAfter:          types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	data.PEM = types.StringValue(pemData)
+	data.FingerprintSHA256 = models[0].FingerprintSHA256
+	data.NotAfter = types.StringValue(notAfter.UTC().Format(time.RFC3339))
+	certificates, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: caCertificateAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+	data.Certificates = certificates
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parsePEMCertificates parses the certificates in the PEM data, skipping blocks of other types.
+func parsePEMCertificates(pemData string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse certificate %d", len(certs)+1)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found in the PEM data")
+	}
+	return certs, nil
+}
+
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCertificate returns a self-signed CA certificate in PEM and the hex SHA-256 of its DER.
+func testCertificate(t *testing.T, commonName string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	sum := sha256.Sum256(der)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), hex.EncodeToString(sum[:])
+}
+
+func TestClusterCACertificateRead(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	intermediate, intermediateFingerprint := testCertificate(t, "rwc-intermediate", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC))
+	root, rootFingerprint := testCertificate(t, "rwc-root", time.Date(2036, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name              string
+		values            map[string]func(tftypes.Type) tftypes.Value
+		expect            func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError       string
+		expectFingerprint string
+		expectNotAfter    string
+		expectSubjects    []string
+	}{
+		{
+			name: "chain of a cluster",
+			values: map[string]func(tftypes.Type) tftypes.Value{
+				"cluster_id": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, nsID.String()) },
+			},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetClusterCACertificate(gomock.Any(), nsID).Return(intermediate+root, nil)
+			},
+			expectFingerprint: intermediateFingerprint,
+			expectNotAfter:    "2027-03-01T00:00:00Z",
+			expectSubjects:    []string{"CN=rwc-intermediate", "CN=rwc-root"},
+		},
+		{
+			name: "root CA of a region",
+			values: map[string]func(tftypes.Type) tftypes.Value{
+				"region": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, "us-east-1") },
+			},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetRootCACertificate(gomock.Any(), "us-east-1").Return(root, nil)
+			},
+			expectFingerprint: rootFingerprint,
+			expectNotAfter:    "2036-01-01T00:00:00Z",
+			expectSubjects:    []string{"CN=rwc-root"},
+		},
+		{
+			name: "no certificate",
+			values: map[string]func(tftypes.Type) tftypes.Value{
+				"region": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, "us-east-1") },
+			},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetRootCACertificate(gomock.Any(), "us-east-1").Return("<html>not found</html>", nil)
+			},
+			expectError: "no certificate found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			d := &ClusterCACertificateDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			config := dataSourceConfig(ctx, schemaResp, tt.values)
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw},
+			}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if tt.expectError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.expectError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data ClusterCACertificateModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, types.StringValue(tt.expectFingerprint), data.FingerprintSHA256)
+			assert.Equal(t, types.StringValue(tt.expectNotAfter), data.NotAfter)
+
+			var certificates []CACertificateModel
+			require.False(t, data.Certificates.ElementsAs(ctx, &certificates, false).HasError())
+			var subjects []string
+			for _, cert := range certificates {
+				subjects = append(subjects, cert.Subject.ValueString())
+			}
+			assert.Equal(t, tt.expectSubjects, subjects)
+			assert.Equal(t, types.StringValue(tt.expectFingerprint), certificates[0].FingerprintSHA256)
+		})
+	}
+}
+
+func TestClusterCACertificateValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	d := &ClusterCACertificateDataSource{}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	clusterID := func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, uuid.NewString()) }
+	region := func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, "us-east-1") }
+
+	for name, tt := range map[string]struct {
+		values      map[string]func(tftypes.Type) tftypes.Value
+		expectError bool
+	}{
+		"none":    {values: map[string]func(tftypes.Type) tftypes.Value{}, expectError: true},
+		"cluster": {values: map[string]func(tftypes.Type) tftypes.Value{"cluster_id": clusterID}},
+		"region":  {values: map[string]func(tftypes.Type) tftypes.Value{"region": region}},
+		"both":    {values: map[string]func(tftypes.Type) tftypes.Value{"cluster_id": clusterID, "region": region}, expectError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: dataSourceConfig(ctx, schemaResp, tt.values),
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+		})
+	}
+}
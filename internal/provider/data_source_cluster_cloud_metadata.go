@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterCloudMetadataDataSource{}
+
+func NewClusterCloudMetadataDataSource() datasource.DataSource {
+	return &ClusterCloudMetadataDataSource{}
+}
+
+// ClusterCloudMetadataDataSource reads the metadata of the cloud resources of a cluster, e.g. the
+// egress IPs to allow in the firewalls of the upstream systems.
+type ClusterCloudMetadataDataSource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterCloudMetadataModel struct {
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Region        types.String `tfsdk:"region"`
+	Network       types.Object `tfsdk:"network"`
+	IAM           types.Object `tfsdk:"iam"`
+}
+
+type CloudMetadataNetworkAWSModel struct {
+	NatGatewayIPs         types.List   `tfsdk:"nat_gateway_ips"`
+	PrivateLinkPrincipals types.List   `tfsdk:"private_link_principals"`
+	ServingPrivateLink    types.Object `tfsdk:"serving_private_link"`
+}
+
+type CloudMetadataServingPrivateLinkModel struct {
+	ServiceName types.String `tfsdk:"service_name"`
+	Host        types.String `tfsdk:"host"`
+	Port        types.Int64  `tfsdk:"port"`
+}
+
+type CloudMetadataNetworkGCPModel struct {
+	NatGatewayIPs types.List   `tfsdk:"nat_gateway_ips"`
+	PscProject    types.String `tfsdk:"psc_project"`
+}
+
+type CloudMetadataIAMAWSModel struct {
+	WorkloadRoleArn types.String `tfsdk:"workload_role_arn"`
+}
+
+type CloudMetadataIAMGCPModel struct {
+	WorkloadGsa types.String `tfsdk:"workload_gsa"`
+}
+
+var cloudMetadataServingPrivateLinkAttrTypes = map[string]attr.Type{
+	"service_name": types.StringType,
+	"host":         types.StringType,
+	"port":         types.Int64Type,
+}
+
+var cloudMetadataNetworkAWSAttrTypes = map[string]attr.Type{
+	"nat_gateway_ips":         types.ListType{ElemType: types.StringType},
+	"private_link_principals": types.ListType{ElemType: types.StringType},
+	"serving_private_link":    types.ObjectType{AttrTypes: cloudMetadataServingPrivateLinkAttrTypes},
+}
+
+var cloudMetadataNetworkGCPAttrTypes = map[string]attr.Type{
+	"nat_gateway_ips": types.ListType{ElemType: types.StringType},
+	"psc_project":     types.StringType,
+}
+
+var cloudMetadataNetworkAttrTypes = map[string]attr.Type{
+	"aws": types.ObjectType{AttrTypes: cloudMetadataNetworkAWSAttrTypes},
+	"gcp": types.ObjectType{AttrTypes: cloudMetadataNetworkGCPAttrTypes},
+}
+
+var cloudMetadataIAMAWSAttrTypes = map[string]attr.Type{
+	"workload_role_arn": types.StringType,
+}
+
+var cloudMetadataIAMGCPAttrTypes = map[string]attr.Type{
+	"workload_gsa": types.StringType,
+}
+
+var cloudMetadataIAMAttrTypes = map[string]attr.Type{
+	"aws": types.ObjectType{AttrTypes: cloudMetadataIAMAWSAttrTypes},
+	"gcp": types.ObjectType{AttrTypes: cloudMetadataIAMGCPAttrTypes},
+}
+
+func (d *ClusterCloudMetadataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_cloud_metadata"
+}
+
+func (d *ClusterCloudMetadataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	natGatewayIPs := schema.ListAttribute{
+		MarkdownDescription: "The public IPs the cluster connects to the internet from.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "The metadata of the cloud resources of a RisingWave cluster, e.g. its egress IPs and workload identity.",
+		MarkdownDescription: clusterCloudMetadataMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "The cloud provider the cluster runs on, e.g. `aws` or `gcp`.",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The cloud region the cluster runs in.",
+				Computed:            true,
+			},
+			"network": schema.SingleNestedAttribute{
+				MarkdownDescription: "The network of the cluster. Only the attribute of the cloud provider of the cluster is set.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						MarkdownDescription: "The network of a cluster on AWS.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"nat_gateway_ips": natGatewayIPs,
+							"private_link_principals": schema.ListAttribute{
+								MarkdownDescription: "The IAM principals to allow on a VPC endpoint service for the cluster to " +
+									"connect to it through PrivateLink, e.g. with `aws_vpc_endpoint_service_allowed_principal`.",
+								ElementType: types.StringType,
+								Computed:    true,
+							},
+							"serving_private_link": schema.SingleNestedAttribute{
+								MarkdownDescription: "The PrivateLink service the cluster is served through, if any.",
+								Computed:            true,
+								Attributes: map[string]schema.Attribute{
+									"service_name": schema.StringAttribute{
+										MarkdownDescription: "The name of the VPC endpoint service to create an endpoint to.",
+										Computed:            true,
+									},
+									"host": schema.StringAttribute{
+										MarkdownDescription: "The host to connect to through the endpoint.",
+										Computed:            true,
+									},
+									"port": schema.Int64Attribute{
+										MarkdownDescription: "The port to connect to through the endpoint.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						MarkdownDescription: "The network of a cluster on GCP.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"nat_gateway_ips": natGatewayIPs,
+							"psc_project": schema.StringAttribute{
+								MarkdownDescription: "The GCP project to allow on a Private Service Connect service attachment " +
+									"for the cluster to connect to it.",
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+			"iam": schema.SingleNestedAttribute{
+				MarkdownDescription: "The workload identity of the cluster. Only the attribute of the cloud provider of the cluster is set.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						MarkdownDescription: "The workload identity of a cluster on AWS.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"workload_role_arn": schema.StringAttribute{
+								MarkdownDescription: "The ARN of the IAM role the nodes of the cluster run as.",
+								Computed:            true,
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						MarkdownDescription: "The workload identity of a cluster on GCP.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"workload_gsa": schema.StringAttribute{
+								MarkdownDescription: "The Google service account the nodes of the cluster run as.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterCloudMetadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterCloudMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterCloudMetadataModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	meta, err := d.client.GetClusterCloudMetadata(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the cloud metadata of the cluster", err.Error())
+		return
+	}
+
+	data.CloudProvider = types.StringValue(meta.CloudProvider)
+	data.Region = types.StringValue(meta.Region)
+	data.Network, data.IAM = cloudMetadataToDataModel(ctx, meta, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// cloudMetadataToDataModel decodes the network and IAM unions into the variant of the cloud
+// provider of the cluster. The unions carry no discriminator of their own, and any variant decodes
+// from any payload, so the cloud provider is the only way to tell them apart.
+func cloudMetadataToDataModel(ctx context.Context, meta *apigen_mgmtv2.TenantCloudMetadata, diags *diag.Diagnostics) (types.Object, types.Object) {
+	var (
+		networkAWS = types.ObjectNull(cloudMetadataNetworkAWSAttrTypes)
+		networkGCP = types.ObjectNull(cloudMetadataNetworkGCPAttrTypes)
+		iamAWS     = types.ObjectNull(cloudMetadataIAMAWSAttrTypes)
+		iamGCP     = types.ObjectNull(cloudMetadataIAMGCPAttrTypes)
+	)
+
+	objectValue := func(attrTypes map[string]attr.Type, model interface{}) types.Object {
+		value, d := types.ObjectValueFrom(ctx, attrTypes, model)
+		diags.Append(d...)
+		return value
+	}
+	listValue := func(values []string) types.List {
+		if values == nil {
+			values = []string{}
+		}
+		value, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return value
+	}
+
+	switch strings.ToLower(meta.CloudProvider) {
+	case "aws":
+		if meta.Network != nil {
+			network, err := meta.Network.AsTenantCloudMetadataNetworkAWS()
+			if err != nil {
+				diags.AddError("Unable to decode the network metadata", err.Error())
+				break
+			}
+			// fall back to the legacy single principal for platforms that do not list them.
+			var principals []string
+			if network.PrivateLinkPrincipals != nil {
+				principals = *network.PrivateLinkPrincipals
+			} else if network.PrivateLinkPrincipal != nil {
+				principals = []string{*network.PrivateLinkPrincipal}
+			}
+			servingPrivateLink := types.ObjectNull(cloudMetadataServingPrivateLinkAttrTypes)
+			if network.ServingPrivateLink != nil {
+				servingPrivateLink = objectValue(cloudMetadataServingPrivateLinkAttrTypes, CloudMetadataServingPrivateLinkModel{
+					ServiceName: types.StringValue(network.ServingPrivateLink.ServiceName),
+					Host:        types.StringValue(network.ServingPrivateLink.Host),
+					Port:        types.Int64Value(int64(network.ServingPrivateLink.Port)),
+				})
+			}
+			networkAWS = objectValue(cloudMetadataNetworkAWSAttrTypes, CloudMetadataNetworkAWSModel{
+				NatGatewayIPs:         listValue(network.NatGatewayIps),
+				PrivateLinkPrincipals: listValue(principals),
+				ServingPrivateLink:    servingPrivateLink,
+			})
+		}
+		if meta.Iam != nil {
+			iam, err := meta.Iam.AsTenantCloudMetadataIAMAWS()
+			if err != nil {
+				diags.AddError("Unable to decode the IAM metadata", err.Error())
+				break
+			}
+			iamAWS = objectValue(cloudMetadataIAMAWSAttrTypes, CloudMetadataIAMAWSModel{
+				WorkloadRoleArn: types.StringValue(iam.WorkloadRoleArn),
+			})
+		}
+	case "gcp":
+		if meta.Network != nil {
+			network, err := meta.Network.AsTenantCloudMetadataNetworkGCP()
+			if err != nil {
+				diags.AddError("Unable to decode the network metadata", err.Error())
+				break
+			}
+			var natGatewayIPs []string
+			if network.NatGatewayIps != nil {
+				natGatewayIPs = *network.NatGatewayIps
+			}
+			networkGCP = objectValue(cloudMetadataNetworkGCPAttrTypes, CloudMetadataNetworkGCPModel{
+				NatGatewayIPs: listValue(natGatewayIPs),
+				PscProject:    types.StringPointerValue(network.PscProject),
+			})
+		}
+		if meta.Iam != nil {
+			iam, err := meta.Iam.AsTenantCloudMetadataIAMGCP()
+			if err != nil {
+				diags.AddError("Unable to decode the IAM metadata", err.Error())
+				break
+			}
+			iamGCP = objectValue(cloudMetadataIAMGCPAttrTypes, CloudMetadataIAMGCPModel{
+				WorkloadGsa: types.StringValue(iam.WorkloadGsa),
+			})
+		}
+	default:
+		diags.AddWarning(
+			"Unsupported cloud provider",
+			fmt.Sprintf("The network and IAM metadata of clusters on %q are not supported, so they are left empty.", meta.CloudProvider),
+		)
+	}
+
+	network, d := types.ObjectValue(cloudMetadataNetworkAttrTypes, map[string]attr.Value{"aws": networkAWS, "gcp": networkGCP})
+	diags.Append(d...)
+	iam, d := types.ObjectValue(cloudMetadataIAMAttrTypes, map[string]attr.Value{"aws": iamAWS, "gcp": iamGCP})
+	diags.Append(d...)
+	return network, iam
+}
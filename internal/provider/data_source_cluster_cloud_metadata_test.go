@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterCloudMetadataRead(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	stringList := func(values ...string) types.List {
+		elems := []attr.Value{}
+		for _, v := range values {
+			elems = append(elems, types.StringValue(v))
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	object := func(attrTypes map[string]attr.Type, attrs map[string]attr.Value) types.Object {
+		return types.ObjectValueMust(attrTypes, attrs)
+	}
+
+	awsMeta := func() *apigen_mgmtv2.TenantCloudMetadata {
+		var network apigen_mgmtv2.TenantCloudMetadataNetwork
+		require.NoError(t, network.FromTenantCloudMetadataNetworkAWS(apigen_mgmtv2.TenantCloudMetadataNetworkAWS{
+			NatGatewayIps:        []string{"203.0.113.10", "203.0.113.11"},
+			PrivateLinkPrincipal: ptr.Ptr("arn:aws:iam::123456789012:root"),
+			ServingPrivateLink: &apigen_mgmtv2.TenantCloudMetadataAWSServingPrivateLinkInfo{
+				ServiceName: "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789",
+				Host:        "mycluster.internal",
+				Port:        4566,
+			},
+		}))
+		var iam apigen_mgmtv2.TenantCloudMetadataIAM
+		require.NoError(t, iam.FromTenantCloudMetadataIAMAWS(apigen_mgmtv2.TenantCloudMetadataIAMAWS{
+			WorkloadRoleArn: "arn:aws:iam::123456789012:role/mycluster",
+		}))
+		return &apigen_mgmtv2.TenantCloudMetadata{CloudProvider: "aws", Region: "us-east-1", Network: &network, Iam: &iam}
+	}
+	gcpMeta := func() *apigen_mgmtv2.TenantCloudMetadata {
+		var network apigen_mgmtv2.TenantCloudMetadataNetwork
+		require.NoError(t, network.FromTenantCloudMetadataNetworkGCP(apigen_mgmtv2.TenantCloudMetadataNetworkGCP{
+			PscProject: ptr.Ptr("rw-prod"),
+		}))
+		var iam apigen_mgmtv2.TenantCloudMetadataIAM
+		require.NoError(t, iam.FromTenantCloudMetadataIAMGCP(apigen_mgmtv2.TenantCloudMetadataIAMGCP{
+			WorkloadGsa: "mycluster@rw-prod.iam.gserviceaccount.com",
+		}))
+		return &apigen_mgmtv2.TenantCloudMetadata{CloudProvider: "gcp", Region: "us-central1", Network: &network, Iam: &iam}
+	}
+
+	var (
+		nullNetworkAWS = types.ObjectNull(cloudMetadataNetworkAWSAttrTypes)
+		nullNetworkGCP = types.ObjectNull(cloudMetadataNetworkGCPAttrTypes)
+		nullIAMAWS     = types.ObjectNull(cloudMetadataIAMAWSAttrTypes)
+		nullIAMGCP     = types.ObjectNull(cloudMetadataIAMGCPAttrTypes)
+	)
+
+	tests := []struct {
+		name          string
+		meta          *apigen_mgmtv2.TenantCloudMetadata
+		expectWarning bool
+		expectNetwork map[string]attr.Value
+		expectIAM     map[string]attr.Value
+	}{
+		{
+			name: "aws with the legacy principal",
+			meta: awsMeta(),
+			expectNetwork: map[string]attr.Value{
+				"aws": object(cloudMetadataNetworkAWSAttrTypes, map[string]attr.Value{
+					"nat_gateway_ips":         stringList("203.0.113.10", "203.0.113.11"),
+					"private_link_principals": stringList("arn:aws:iam::123456789012:root"),
+					"serving_private_link": object(cloudMetadataServingPrivateLinkAttrTypes, map[string]attr.Value{
+						"service_name": types.StringValue("com.amazonaws.vpce.us-east-1.vpce-svc-0123456789"),
+						"host":         types.StringValue("mycluster.internal"),
+						"port":         types.Int64Value(4566),
+					}),
+				}),
+				"gcp": nullNetworkGCP,
+			},
+			expectIAM: map[string]attr.Value{
+				"aws": object(cloudMetadataIAMAWSAttrTypes, map[string]attr.Value{
+					"workload_role_arn": types.StringValue("arn:aws:iam::123456789012:role/mycluster"),
+				}),
+				"gcp": nullIAMGCP,
+			},
+		},
+		{
+			name: "gcp",
+			meta: gcpMeta(),
+			expectNetwork: map[string]attr.Value{
+				"aws": nullNetworkAWS,
+				"gcp": object(cloudMetadataNetworkGCPAttrTypes, map[string]attr.Value{
+					"nat_gateway_ips": stringList(),
+					"psc_project":     types.StringValue("rw-prod"),
+				}),
+			},
+			expectIAM: map[string]attr.Value{
+				"aws": nullIAMAWS,
+				"gcp": object(cloudMetadataIAMGCPAttrTypes, map[string]attr.Value{
+					"workload_gsa": types.StringValue("mycluster@rw-prod.iam.gserviceaccount.com"),
+				}),
+			},
+		},
+		{
+			name:          "unsupported cloud provider",
+			meta:          &apigen_mgmtv2.TenantCloudMetadata{CloudProvider: "azure", Region: "eastus"},
+			expectWarning: true,
+			expectNetwork: map[string]attr.Value{"aws": nullNetworkAWS, "gcp": nullNetworkGCP},
+			expectIAM:     map[string]attr.Value{"aws": nullIAMAWS, "gcp": nullIAMGCP},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.EXPECT().GetClusterCloudMetadata(gomock.Any(), nsID).Return(tt.meta, nil)
+
+			d := &ClusterCloudMetadataDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			config := dataSourceConfig(ctx, schemaResp, map[string]func(tftypes.Type) tftypes.Value{
+				"cluster_id": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, nsID.String()) },
+			})
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw},
+			}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() > 0)
+
+			var data ClusterCloudMetadataModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, types.StringValue(tt.meta.CloudProvider), data.CloudProvider)
+			assert.Equal(t, types.StringValue(tt.meta.Region), data.Region)
+			assert.Equal(t, object(cloudMetadataNetworkAttrTypes, tt.expectNetwork), data.Network)
+			assert.Equal(t, object(cloudMetadataIAMAttrTypes, tt.expectIAM), data.IAM)
+		})
+	}
+}
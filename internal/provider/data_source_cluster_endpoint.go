@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterEndpointDataSource{}
+
+func NewClusterEndpointDataSource() datasource.DataSource {
+	return &ClusterEndpointDataSource{}
+}
+
+// ClusterEndpointDataSource reads the endpoint to connect to a cluster with a Postgres client.
+type ClusterEndpointDataSource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterEndpointDataSourceModel struct {
+	ClusterID          types.String `tfsdk:"cluster_id"`
+	Username           types.String `tfsdk:"username"`
+	Host               types.String `tfsdk:"host"`
+	Port               types.Int64  `tfsdk:"port"`
+	Database           types.String `tfsdk:"database"`
+	InternalHost       types.String `tfsdk:"internal_host"`
+	InternalPort       types.Int64  `tfsdk:"internal_port"`
+	Options            types.String `tfsdk:"options"`
+	Private            types.Bool   `tfsdk:"private"`
+	ServingPrivateLink types.Object `tfsdk:"serving_private_link"`
+	ConnectionURI      types.String `tfsdk:"connection_uri"`
+}
+
+type ServingPrivateLinkModel struct {
+	ServiceName types.String `tfsdk:"service_name"`
+	Host        types.String `tfsdk:"host"`
+	Port        types.Int64  `tfsdk:"port"`
+	Azs         types.List   `tfsdk:"azs"`
+}
+
+var servingPrivateLinkAttrTypes = map[string]attr.Type{
+	"service_name": types.StringType,
+	"host":         types.StringType,
+	"port":         types.Int64Type,
+	"azs":          types.ListType{ElemType: types.StringType},
+}
+
+func (d *ClusterEndpointDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_endpoint"
+}
+
+func (d *ClusterEndpointDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The endpoint to connect to a RisingWave cluster with a Postgres client.",
+		MarkdownDescription: clusterEndpointMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user to connect as in `connection_uri`, e.g. the `username` of a `risingwavecloud_cluster_user` resource.",
+				Optional:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The host to connect to.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port to connect to.",
+				Computed:            true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The database to connect to by default.",
+				Computed:            true,
+			},
+			"internal_host": schema.StringAttribute{
+				MarkdownDescription: "The host to connect to from inside the network of the cluster, e.g. from a BYOC environment.",
+				Computed:            true,
+			},
+			"internal_port": schema.Int64Attribute{
+				MarkdownDescription: "The port to connect to from inside the network of the cluster.",
+				Computed:            true,
+			},
+			"options": schema.StringAttribute{
+				MarkdownDescription: "The value of the `options` connection parameter to connect with, if any.",
+				Computed:            true,
+			},
+			"private": schema.BoolAttribute{
+				MarkdownDescription: "Whether `host` is only reachable through a private network, e.g. through `serving_private_link`.",
+				Computed:            true,
+			},
+			"serving_private_link": schema.SingleNestedAttribute{
+				MarkdownDescription: "The AWS PrivateLink service the cluster is served through, if any.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"service_name": schema.StringAttribute{
+						MarkdownDescription: "The name of the VPC endpoint service to create an endpoint to.",
+						Computed:            true,
+					},
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The host to connect to through the endpoint.",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port to connect to through the endpoint.",
+						Computed:            true,
+					},
+					"azs": schema.ListAttribute{
+						MarkdownDescription: "The availability zones of the service.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+				},
+			},
+			"connection_uri": schema.StringAttribute{
+				MarkdownDescription: "The Postgres connection URI of the cluster, e.g. `postgresql://user@host:4566/dev`, " +
+					"with the `username` if set. It carries no password.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *ClusterEndpointDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterEndpointDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterEndpointDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	endpoint, err := d.client.GetClusterEndpoint(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the endpoint of the cluster", err.Error())
+		return
+	}
+
+	data.Host = types.StringValue(endpoint.Host)
+	data.Port = types.Int64Value(int64(endpoint.Port))
+	data.Database = types.StringValue(endpoint.Database)
+	data.InternalHost = types.StringValue(endpoint.InternalHost)
+	data.InternalPort = types.Int64Value(int64(endpoint.InternalPort))
+	data.Options = types.StringValue(endpoint.Options)
+	data.Private = types.BoolValue(endpoint.IsUserFacingEndpointPrivate != nil && *endpoint.IsUserFacingEndpointPrivate)
+	data.ConnectionURI = types.StringValue(clusterConnectionURI(endpoint, data.Username.ValueString()))
+
+	data.ServingPrivateLink = types.ObjectNull(servingPrivateLinkAttrTypes)
+	if pl := endpoint.AwsServingPrivateLink; pl != nil {
+		azs, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, pl.Azs...))
+		resp.Diagnostics.Append(diags...)
+		value, diags := types.ObjectValueFrom(ctx, servingPrivateLinkAttrTypes, ServingPrivateLinkModel{
+			ServiceName: types.StringValue(pl.ServiceName),
+			Host:        types.StringValue(pl.Host),
+			Port:        types.Int64Value(int64(pl.Port)),
+			Azs:         azs,
+		})
+		resp.Diagnostics.Append(diags...)
+		data.ServingPrivateLink = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// clusterConnectionURI returns the Postgres connection URI of the endpoint. The options are passed
+// as the options parameter, percent-encoded since libpq does not decode a `+` into a space.
+func clusterConnectionURI(endpoint *apigen_mgmtv2.Endpoint, username string) string {
+	uri := url.URL{
+		Scheme: "postgresql",
+		Host:   net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port)),
+		Path:   "/" + endpoint.Database,
+	}
+	if username != "" {
+		uri.User = url.User(username)
+	}
+	if endpoint.Options != "" {
+		uri.RawQuery = "options=" + strings.ReplaceAll(url.QueryEscape(endpoint.Options), "+", "%20")
+	}
+	return uri.String()
+}
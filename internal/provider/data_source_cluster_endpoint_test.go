@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterConnectionURI(t *testing.T) {
+	endpoint := &apigen_mgmtv2.Endpoint{Host: "mycluster.risingwave.cloud", Port: 4566, Database: "dev"}
+	assert.Equal(t, "postgresql://mycluster.risingwave.cloud:4566/dev", clusterConnectionURI(endpoint, ""))
+	assert.Equal(t, "postgresql://app@mycluster.risingwave.cloud:4566/dev", clusterConnectionURI(endpoint, "app"))
+
+	endpoint.Options = "--tenant=rwc-abc -c search_path=app"
+	assert.Equal(t,
+		"postgresql://app%40corp@mycluster.risingwave.cloud:4566/dev?options=--tenant%3Drwc-abc%20-c%20search_path%3Dapp",
+		clusterConnectionURI(endpoint, "app@corp"),
+	)
+
+	endpoint.Host = "fd00::1"
+	endpoint.Options = ""
+	assert.Equal(t, "postgresql://app@[fd00::1]:4566/dev", clusterConnectionURI(endpoint, "app"))
+}
+
+func TestClusterEndpointRead(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	ctrl := gomock.NewController(t)
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	client.EXPECT().GetClusterEndpoint(gomock.Any(), nsID).Return(&apigen_mgmtv2.Endpoint{
+		NsId:                        nsID,
+		Host:                        "mycluster.internal",
+		Port:                        4566,
+		Database:                    "dev",
+		InternalHost:                "risingwave-frontend.rwc.svc",
+		InternalPort:                4567,
+		Options:                     "--tenant=rwc-abc",
+		IsUserFacingEndpointPrivate: ptr.Ptr(true),
+		AwsServingPrivateLink: &apigen_mgmtv2.AWSServingPrivateLinkInfo{
+			ServiceName: "com.amazonaws.vpce.us-east-1.vpce-svc-0123456789",
+			Host:        "mycluster.internal",
+			Port:        4566,
+			Azs:         []string{"use1-az1", "use1-az2"},
+		},
+	}, nil)
+
+	d := &ClusterEndpointDataSource{client: client}
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	config := dataSourceConfig(ctx, schemaResp, map[string]func(tftypes.Type) tftypes.Value{
+		"cluster_id": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, nsID.String()) },
+		"username":   func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, "app") },
+	})
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw},
+	}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var data ClusterEndpointDataSourceModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, ClusterEndpointDataSourceModel{
+		ClusterID:    types.StringValue(nsID.String()),
+		Username:     types.StringValue("app"),
+		Host:         types.StringValue("mycluster.internal"),
+		Port:         types.Int64Value(4566),
+		Database:     types.StringValue("dev"),
+		InternalHost: types.StringValue("risingwave-frontend.rwc.svc"),
+		InternalPort: types.Int64Value(4567),
+		Options:      types.StringValue("--tenant=rwc-abc"),
+		Private:      types.BoolValue(true),
+		ServingPrivateLink: types.ObjectValueMust(servingPrivateLinkAttrTypes, map[string]attr.Value{
+			"service_name": types.StringValue("com.amazonaws.vpce.us-east-1.vpce-svc-0123456789"),
+			"host":         types.StringValue("mycluster.internal"),
+			"port":         types.Int64Value(4566),
+			"azs": types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("use1-az1"),
+				types.StringValue("use1-az2"),
+			}),
+		}),
+		ConnectionURI: types.StringValue("postgresql://app@mycluster.internal:4566/dev?options=--tenant%3Drwc-abc"),
+	}, data)
+}
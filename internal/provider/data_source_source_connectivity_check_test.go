@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dataSourceConfig returns a config of the data source with the given attributes set
+// and the others null.
+func dataSourceConfig(ctx context.Context, s datasource.SchemaResponse, values map[string]func(tftypes.Type) tftypes.Value) tfsdk.Config {
+	objectType := s.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(typ, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value(typ)
+		}
+	}
+	return tfsdk.Config{Schema: s.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestSourceConnectivityCheckRead(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	kafkaConfig := apigen_mgmtv2.KafkaConfig{
+		Server:           "broker-1:9092",
+		SecurityProtocol: ptr.Ptr(apigen_mgmtv2.SASLSSL),
+		SaslUsername:     ptr.Ptr("rw"),
+		SaslPassword:     ptr.Ptr("secret"),
+	}
+	kafka := func(typ tftypes.Type) tftypes.Value {
+		objectType := typ.(tftypes.Object)
+		values := map[string]tftypes.Value{}
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["bootstrap_server"] = tftypes.NewValue(tftypes.String, "broker-1:9092")
+		values["security_protocol"] = tftypes.NewValue(tftypes.String, "SASL_SSL")
+		values["sasl_username"] = tftypes.NewValue(tftypes.String, "rw")
+		values["sasl_password"] = tftypes.NewValue(tftypes.String, "secret")
+		values["topic"] = tftypes.NewValue(tftypes.String, "orders")
+		return tftypes.NewValue(objectType, values)
+	}
+	postgres := func(typ tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"hostname": tftypes.NewValue(tftypes.String, "db.internal"),
+			"port":     tftypes.NewValue(tftypes.Number, 5432),
+			"username": tftypes.NewValue(tftypes.String, "rw"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+			"database": tftypes.NewValue(tftypes.String, "shop"),
+			"ssl_mode": tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+	listObjects := func(typ tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(typ, true)
+	}
+
+	tests := []struct {
+		name          string
+		values        map[string]func(tftypes.Type) tftypes.Value
+		expect        func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError   string
+		expectTopics  []string
+		expectTables  []string
+		expectColumns []SourceColumnModel
+	}{
+		{
+			name:   "kafka reachable",
+			values: map[string]func(tftypes.Type) tftypes.Value{"kafka": kafka, "list_objects": listObjects},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				var pingReq apigen_mgmtv2.PostSourcesPingRequestBody
+				pingReq.Type = apigen_mgmtv2.Kafka
+				require.NoError(t, pingReq.Config.FromKafkaConfig(kafkaConfig))
+				gomock.InOrder(
+					client.EXPECT().PingSource(gomock.Any(), nsID, pingReq).Return(nil),
+					client.EXPECT().FetchKafkaTopics(gomock.Any(), nsID, kafkaConfig).Return([]string{"orders", "payments"}, nil),
+					client.EXPECT().FetchKafkaSchema(gomock.Any(), nsID, kafkaConfig, "orders").Return([]apigen_mgmtv2.ColumnDesc{
+						{Name: "id", Type: "bigint"},
+						{Name: "amount", Type: "numeric"},
+					}, nil),
+				)
+			},
+			expectTopics: []string{"orders", "payments"},
+			expectColumns: []SourceColumnModel{
+				{Name: types.StringValue("id"), Type: types.StringValue("bigint")},
+				{Name: types.StringValue("amount"), Type: types.StringValue("numeric")},
+			},
+		},
+		{
+			name:   "postgres reachable without listing",
+			values: map[string]func(tftypes.Type) tftypes.Value{"postgres_cdc": postgres},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().PingSource(gomock.Any(), nsID, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "postgres unreachable",
+			values: map[string]func(tftypes.Type) tftypes.Value{"postgres_cdc": postgres, "list_objects": listObjects},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().PingSource(gomock.Any(), nsID, gomock.Any()).
+					Return(errors.Wrap(cloudsdk.ErrSourceUnreachable, "password authentication failed for user \"rw\""))
+			},
+			expectError: "password authentication failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			d := &SourceConnectivityCheckDataSource{client: client}
+
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			values := map[string]func(tftypes.Type) tftypes.Value{
+				"cluster_id": func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, nsID.String()) },
+			}
+			for name, value := range tt.values {
+				values[name] = value
+			}
+			config := dataSourceConfig(ctx, schemaResp, values)
+
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw},
+			}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if tt.expectError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.expectError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data SourceConnectivityCheckModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+
+			var topics, tables []string
+			var columns []SourceColumnModel
+			require.False(t, data.Topics.ElementsAs(ctx, &topics, false).HasError())
+			require.False(t, data.Tables.ElementsAs(ctx, &tables, false).HasError())
+			require.False(t, data.Columns.ElementsAs(ctx, &columns, false).HasError())
+			assert.Equal(t, tt.expectTopics, topics)
+			assert.Equal(t, tt.expectTables, tables)
+			assert.Equal(t, tt.expectColumns, columns)
+		})
+	}
+}
+
+func TestSourceConnectivityCheckValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	d := &SourceConnectivityCheckDataSource{}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	mongodb := func(typ tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"connection_string": tftypes.NewValue(tftypes.String, "mongodb://localhost:27017"),
+		})
+	}
+	unknown := func(typ tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	for name, tt := range map[string]struct {
+		values      map[string]func(tftypes.Type) tftypes.Value
+		expectError bool
+	}{
+		"none":    {values: map[string]func(tftypes.Type) tftypes.Value{}, expectError: true},
+		"one":     {values: map[string]func(tftypes.Type) tftypes.Value{"mongodb_cdc": mongodb}},
+		"unknown": {values: map[string]func(tftypes.Type) tftypes.Value{"kafka": unknown}},
+		"two":     {values: map[string]func(tftypes.Type) tftypes.Value{"mongodb_cdc": mongodb, "kafka": unknown}, expectError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{
+				Config: dataSourceConfig(ctx, schemaResp, tt.values),
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+		})
+	}
+}
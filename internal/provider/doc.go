@@ -95,6 +95,188 @@ Please report any issues at the [GitHub repository](https://github.com/risingwav
 var clusterMarkdownDescription = `
 A managed RisingWave Cluster on the RisingWave Cloud platform.
 
+## Restore a Cluster from a Backup Snapshot
+
+Set ` + "`" + `restore_from` + "`" + ` to create the cluster from a backup snapshot of another cluster instead of
+starting it empty, for a disaster recovery drill or a staging clone for example:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "clone" {
+    region  = "us-east-1"
+    name    = "mycluster-clone"
+    version = "v2.1.2"
+    restore_from = {
+      cluster_id  = risingwavecloud_cluster.mycluster.id
+      snapshot_id = risingwavecloud_cluster_backup.nightly.snapshot_id
+    }
+    spec = {
+      # ...
+    }
+  }
+` + "```" + `
+
+The snapshot is restored into a new cluster in the region of the source cluster, which keeps the
+tier, the BYOC environment and the metastore of the source. Once it is running and healthy, the
+` + "`" + `version` + "`" + ` and the ` + "`" + `spec` + "`" + ` of the configuration are applied to it like to any other cluster.
+` + "`" + `restore_from` + "`" + ` is only used when the cluster is created: pointing it to another snapshot
+replaces the cluster, while removing it leaves the cluster as it is. Adding it to an existing
+cluster, e.g. an imported one, neither restores nor replaces it: use
+` + "`" + `risingwavecloud_cluster_in_place_restore` + "`" + ` to restore the data of an existing cluster.
+
+## Maintenance Window
+
+Set ` + "`" + `maintenance_window` + "`" + ` to pin the time in which the platform may run maintenance on the
+cluster, e.g. automatic upgrades, outside of business hours. The time is in UTC:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "mycluster" {
+    # ...
+    maintenance_window = {
+      day           = 6 # Saturday
+      hour          = 22
+      minute        = 0
+      duration_mins = 240
+    }
+  }
+` + "```" + `
+
+The window is sent when the cluster is created and updated in place afterwards. Changes made
+elsewhere, in the RisingWave Cloud console for instance, show up as a diff on the next plan. Removing
+the attribute stops managing the window without changing it on the platform.
+
+## Serverless Compaction
+
+Set ` + "`" + `extensions.serverless_compaction` + "`" + ` to run compaction on serverless workers instead of the
+cluster's compactor nodes:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "mycluster" {
+    # ...
+    extensions = {
+      serverless_compaction = {
+        maximum_compaction_concurrency = 8
+      }
+    }
+  }
+` + "```" + `
+
+Adding, changing and removing the attribute enables, updates and disables the extension, and the
+apply waits for the cluster to be running again after each of them. An extension enabled elsewhere,
+in the RisingWave Cloud console for instance, shows up as a diff to disable it.
+
+## Compute Cache
+
+Set ` + "`" + `spec.compute_cache` + "`" + ` to size the disk cache of the compute nodes and pick its performance tier:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "mycluster" {
+    # ...
+    spec = {
+      # ...
+      compute_cache = {
+        size_gb          = 100
+        performance_tier = "performance"
+      }
+    }
+  }
+` + "```" + `
+
+The size and the tiers available depend on the cluster. Once the cluster exists, a change is checked
+against them when planning, so an invalid size or tier fails the plan instead of the apply. Changing
+either of them rescales the compute nodes, and the apply waits for the cluster to be running again.
+An unset ` + "`" + `performance_tier` + "`" + ` keeps the current one.
+
+## Power State
+
+Set ` + "`" + `power_state` + "`" + ` to ` + "`" + `stopped` + "`" + ` to stop a cluster without deleting it, e.g. to park a development
+cluster overnight with a scheduled ` + "`" + `terraform apply` + "`" + `, and back to ` + "`" + `running` + "`" + ` to start it again:
+
+` + "```hcl" + `
+  variable "power_state" {
+    default = "running"
+  }
+
+  resource "risingwavecloud_cluster" "mycluster" {
+    # ...
+    power_state = var.power_state
+  }
+` + "```" + `
+
+The apply waits for the cluster to be stopped or running. Changes to the version, the nodes, the
+RisingWave configuration, the compute cache or the extensions cannot be applied to a stopped
+cluster, so applying them starts the cluster, updates it and stops it again. Other changes leave
+it stopped. A cluster stopped or started elsewhere shows up as a diff on the next plan.
+
+## Restart a Cluster
+
+Set ` + "`" + `restart_trigger` + "`" + ` to restart all nodes of the cluster whenever one of its values changes, e.g. to
+pick up a rotated connector secret:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "mycluster" {
+    # ...
+    restart_trigger = {
+      kafka_secret = sha256(var.kafka_password)
+    }
+  }
+` + "```" + `
+
+The nodes are restarted one after another and the apply waits for the cluster to be healthy again.
+Nothing else about the cluster changes. Setting the attribute on a new cluster or removing it does
+not restart anything.
+
+## BYOK Clusters
+
+Set ` + "`" + `byok` + "`" + ` to run the cluster in a BYOK environment. The cluster keeps its metadata in your
+PostgreSQL database and its pods assume an IAM role of yours through IRSA, whose trust policy must allow
+the ` + "`" + `service_account_name` + "`" + ` of the cluster:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster" "mycluster" {
+    region = "us-east-1"
+    name   = "mycluster"
+    byok = {
+      env          = risingwavecloud_byok_environment.prod.name
+      iam_role_arn = "arn:aws:iam::123456789012:role/mycluster"
+      metastore = {
+        host                = aws_db_instance.meta.address
+        port                = 5432
+        database            = "risingwave"
+        username            = "risingwave"
+        password_wo         = var.metastore_password
+        password_wo_version = 1
+      }
+    }
+    # ...
+  }
+
+  resource "aws_iam_role" "mycluster" {
+    name = "mycluster"
+    assume_role_policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect    = "Allow"
+        Action    = "sts:AssumeRoleWithWebIdentity"
+        Principal = { Federated = var.oidc_provider_arn }
+        Condition = {
+          StringEquals = {
+            "${var.oidc_provider}:sub" = "system:serviceaccount:${var.namespace}:${risingwavecloud_cluster.mycluster.service_account_name}"
+          }
+        }
+      }]
+    })
+  }
+` + "```" + `
+
+The platform only deploys a BYOK cluster once it is given its BYOK configuration, and the pods of the
+cluster only start once the IAM role trusts their service account. To allow creating the role from
+` + "`" + `service_account_name` + "`" + ` in the same apply, creating a BYOK cluster does not wait for it to be running,
+and ` + "`" + `iam_role_arn` + "`" + ` is best written out rather than taken from the role, which would be a cycle. A
+BYOK cluster cannot be created stopped.
+
+The BYOK configuration is not reported by the platform, so it is neither read back nor imported. Changing
+it, including ` + "`" + `password_wo_version` + "`" + `, applies it again and waits for the cluster to be running.
+
 ## Import a RisingWave Cluster
 
 To import a RisingWave cluster, follow the steps below:
@@ -194,8 +376,8 @@ var clusterResourceGroupMarkdownDescription = `
 An additional (non-default) resource group in a RisingWave cluster. A resource group is a set of
 compute nodes of its own, used to isolate streaming workloads from each other.
 
-This resource only manages the compute capacity. Assigning a database to a resource group is done in
-SQL when the database is created, and the databases themselves are not managed by this provider.
+This resource only manages the compute capacity. A database is assigned to a resource group when it
+is created, with the ` + "`" + `resource_group` + "`" + ` of a ` + "`" + `risingwavecloud_database` + "`" + ` resource.
 
 !> **Not every cluster can host a resource group.** The cluster must have a separate compute
 component, which means the ` + "`" + `Invited` + "`" + ` or ` + "`" + `BYOC` + "`" + ` tier: a ` + "`" + `Standard` + "`" + ` tier cluster runs
@@ -334,3 +516,819 @@ The resource is identified by the cluster it belongs to, since a cluster has exa
 terraform import risingwavecloud_cluster_allowed_iam_roles.test <cluster_id>
 ` + "```" + `
 `
+
+var clusterBackupMarkdownDescription = `
+An on-demand backup snapshot of a RisingWave cluster. Creating the resource takes a snapshot and
+waits for it to complete; destroying it deletes the snapshot.
+
+A snapshot modelled as a resource can be a dependency of other changes, a snapshot taken before
+upgrading a cluster for example. The cluster cannot reference the snapshot that references it, so
+pass the cluster ID in as a variable, make the cluster depend on the snapshot, and take a new
+snapshot whenever the version changes:
+
+` + "```hcl" + `
+  resource "terraform_data" "rw_version" {
+    input = var.rw_version
+  }
+
+  resource "risingwavecloud_cluster_backup" "pre_upgrade" {
+    cluster_id = var.cluster_id
+
+    lifecycle {
+      replace_triggered_by = [terraform_data.rw_version]
+    }
+  }
+
+  resource "risingwavecloud_cluster" "mycluster" {
+    depends_on = [risingwavecloud_cluster_backup.pre_upgrade]
+
+    version = terraform_data.rw_version.output
+    # ...
+  }
+  ` + "```" + `
+
+~> **Note:** The platform deletes snapshots on its own once they pass their retention period. A
+snapshot deleted that way disappears from the state on the next refresh, and Terraform plans to
+take a new one.
+
+## Import a Backup Snapshot
+
+To import a backup snapshot, follow the steps below:
+
+1. Get the UUID of the corresponding cluster and the UUID of the snapshot from the RisingWave Cloud platform.
+
+2. Write a resource definition to import the snapshot. For example:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster_backup" "test" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+  }
+  ` + "```" + `
+
+3. Run the import command:
+
+` + "```shell" + `
+terraform import risingwavecloud_cluster_backup.test <cluster_id>.<snapshot_id>
+` + "```" + `
+`
+
+var clusterInPlaceRestoreMarkdownDescription = `
+Restores a backup snapshot into the RisingWave cluster it was taken of. Every change made to the
+cluster since the snapshot was taken is discarded, which makes it the way to roll back a bad
+migration without leaving Terraform.
+
+Creating the resource restores the snapshot and waits for the cluster to be running again. The
+restore is refused unless the cluster is running, so that it never races another operation on
+the cluster. Changing ` + "`snapshot_id`" + ` restores the new snapshot; restoring the same snapshot again
+takes ` + "`terraform apply -replace`" + `. Destroying the resource only removes it from the state.
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster_backup" "pre_migration" {
+    cluster_id = var.cluster_id
+  }
+
+  resource "risingwavecloud_cluster_in_place_restore" "rollback" {
+    count = var.rollback ? 1 : 0
+
+    cluster_id        = var.cluster_id
+    snapshot_id       = risingwavecloud_cluster_backup.pre_migration.snapshot_id
+    confirm_data_loss = true
+  }
+  ` + "```" + `
+
+~> **Note:** The restore cannot be undone. Take a new snapshot first if the current data may still
+be needed.
+`
+
+var clusterIcebergCompactionMarkdownDescription = `
+The iceberg compaction extension of a RisingWave cluster. It runs dedicated compactor nodes that
+compact the Iceberg tables the cluster sinks to, so that frequent small commits do not pile up as
+small files. A cluster has at most one iceberg compaction extension.
+
+The compactor nodes use the compactor component types of the cluster's tier, picked from
+` + "`" + `cpu` + "`" + ` and ` + "`" + `memory` + "`" + ` the same way as the components in the cluster's ` + "`" + `spec` + "`" + `.
+Enabling, resizing, reconfiguring, and disabling the extension are asynchronous, and Terraform
+waits for the extension to be running again. They are serialized with the rescales of the same
+cluster, including the ones triggered by ` + "`" + `risingwavecloud_cluster_resource_group` + "`" + `.
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster_iceberg_compaction" "lake" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    compactor = {
+      cpu     = "2"
+      memory  = "8 GB"
+      replica = 1
+    }
+  }
+  ` + "```" + `
+
+## Import an Iceberg Compaction Extension
+
+The extension is imported with the UUID of its cluster:
+
+` + "```shell" + `
+terraform import risingwavecloud_cluster_iceberg_compaction.lake <cluster_id>
+` + "```" + `
+`
+
+var clusterServerlessBackfillMarkdownDescription = `
+The serverless backfill extension of a RisingWave cluster. When a materialized view, sink or index
+is created, its historical data is backfilled by dedicated compute nodes instead of the ones serving
+the existing streaming jobs, so creating a new job does not slow the others down.
+
+The backfill workers run in resource groups whose names start with ` + "`" + `backfill` + "`" + `, which the
+platform manages on its own: they are not visible to ` + "`" + `risingwavecloud_cluster_resource_group` + "`" + `.
+
+Enabling, resizing, upgrading, and disabling the extension rescales the cluster, so Terraform waits for
+the cluster to be running and healthy again. These operations are serialized with the other rescales
+of the same cluster.
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster_serverless_backfill" "backfill" {
+    cluster_id        = risingwavecloud_cluster.mycluster.id
+    component_type_id = "p-2c8g"
+    replica           = 2
+  }
+  ` + "```" + `
+
+## Import a Serverless Backfill Extension
+
+The extension is imported with the UUID of its cluster:
+
+` + "```shell" + `
+terraform import risingwavecloud_cluster_serverless_backfill.backfill <cluster_id>
+` + "```" + `
+
+~> **Note:** The platform does not report the version of the extension, so ` + "`" + `version` + "`" + ` is not set by
+the import. Set it in the configuration to pin the version on the next apply.
+`
+
+var byocEnvironmentMarkdownDescription = `
+A BYOC (Bring Your Own Cloud) environment, the infrastructure in your own cloud account that BYOC
+clusters run on. Clusters run in the environment by setting ` + "`" + `byoc.env` + "`" + ` to its name, so a single
+configuration can provision the environment and the clusters on top of it:
+
+` + "```hcl" + `
+  resource "risingwavecloud_byoc_environment" "prod" {
+    region   = "us-east-1"
+    name     = "prod"
+    settings = var.byoc_settings
+  }
+
+  resource "risingwavecloud_cluster" "mycluster" {
+    region  = risingwavecloud_byoc_environment.prod.region
+    name    = "mycluster"
+    tier    = "BYOC"
+    version = "v2.1.0"
+    byoc = {
+      env = risingwavecloud_byoc_environment.prod.name
+    }
+    ...
+  }
+` + "```" + `
+
+Creating an environment waits until its status is ` + "`" + `Ready` + "`" + `, and fails as soon as it is ` + "`" + `Failed` + "`" + `.
+
+## Settings and Version
+
+Only the settings in the configuration are managed. The platform adds settings of its own, e.g. the
+UUID of the environment, which are left as they are. Removing a setting from the configuration removes
+it from the environment.
+
+Changing ` + "`" + `version` + "`" + ` upgrades the environment in place and waits for it to be ready again. The platform
+does not report the version of an environment, so an upgrade done outside of Terraform does not show
+up in the plan.
+
+## Deletion
+
+Deleting the environment terminates it first, which tears down its infrastructure in the cloud account,
+and waits until it is terminated. Delete the clusters running in it first, e.g. by letting Terraform
+order the deletion through the ` + "`" + `byoc.env` + "`" + ` reference.
+
+## Import a BYOC Environment
+
+` + "```shell" + `
+terraform import risingwavecloud_byoc_environment.prod <region>.<name>
+` + "```" + `
+
+No settings are imported: add the ones to manage to the configuration.
+`
+
+var byokEnvironmentMarkdownDescription = `
+A BYOK (Bring Your Own Kubernetes) environment, a Kubernetes cluster of your own that BYOK clusters
+run on. Clusters run in the environment by setting ` + "`" + `byok.env` + "`" + ` to its name:
+
+` + "```hcl" + `
+  resource "risingwavecloud_byok_environment" "prod" {
+    region = "us-east-1"
+    name   = "prod"
+    config = file("${path.module}/byok.yaml")
+  }
+` + "```" + `
+
+The ` + "`" + `config` + "`" + ` is the BYOK configuration of the environment in YAML. It is only sent when the
+environment is created, so changing it replaces the environment. Creating an environment waits until
+its status is ` + "`" + `Ready` + "`" + `, and fails as soon as it is ` + "`" + `Failed` + "`" + `.
+
+## Import a BYOK Environment
+
+BYOK environments are imported by ` + "`" + `[region].[name]` + "`" + `. The ` + "`" + `config` + "`" + ` and the ` + "`" + `version` + "`" + ` are not
+reported by the platform, so they are not imported.
+
+` + "```shell" + `
+  terraform import risingwavecloud_byok_environment.prod us-east-1.prod
+` + "```" + `
+`
+
+var databaseMarkdownDescription = `
+A database in a RisingWave cluster. The streaming jobs of the database, e.g. its materialized views
+and sinks, run on the compute nodes of its resource group:
+
+` + "```hcl" + `
+  resource "risingwavecloud_cluster_resource_group" "analytics" {
+    cluster_id        = risingwavecloud_cluster.mycluster.id
+    name              = "analytics"
+    component_type_id = "p-2c8g"
+    replica           = 2
+  }
+
+  resource "risingwavecloud_database" "analytics" {
+    cluster_id     = risingwavecloud_cluster.mycluster.id
+    name           = "analytics"
+    resource_group = risingwavecloud_cluster_resource_group.analytics.name
+  }
+` + "```" + `
+
+The resource group of a new database is checked while planning, and a missing one fails the plan. A
+resource group created by the same apply does not exist yet at that point, so it has to be created
+first, e.g. with ` + "`" + `terraform apply -target=risingwavecloud_cluster_resource_group.<name>` + "`" + `.
+
+!> **Deleting the resource drops the database** along with all of its data. So does changing any of its
+attributes, since a database cannot be renamed or moved to another resource group.
+
+## Import a Database
+
+A database created in SQL can be imported with the UUID of its cluster and its name:
+
+` + "```shell" + `
+terraform import risingwavecloud_database.analytics <cluster_id>.<database_name>
+` + "```" + `
+`
+
+var secretMarkdownDescription = `
+A secret in a database of a RisingWave cluster. Sources, sinks and connections refer to a secret
+instead of embedding credentials in their definition, e.g. ` + "`" + `properties.sasl.password = secret kafka_password` + "`" + `.
+
+The value is a [write-only argument](https://developer.hashicorp.com/terraform/language/manage-sensitive-data/write-only),
+so it can come straight from a secret store without ever being recorded in the plan or the state.
+This requires Terraform 1.11 or later.
+
+` + "```hcl" + `
+  data "vault_kv_secret_v2" "kafka" {
+    mount = "kv"
+    name  = "kafka"
+  }
+
+  resource "risingwavecloud_secret" "kafka_password" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    database   = "dev"
+    name       = "kafka_password"
+
+    value_wo         = data.vault_kv_secret_v2.kafka.data["password"]
+    value_wo_version = 1
+  }
+` + "```" + `
+
+Because the value is never stored, Terraform cannot tell that it changed. Increment
+` + "`" + `value_wo_version` + "`" + ` whenever the value changes; that is what makes the provider apply it. A new
+version updates the secret in place with ` + "`" + `ALTER SECRET` + "`" + `, so the objects using it keep running.
+
+~> **Note:** A secret that is still used by a source, sink or connection cannot be deleted. The
+provider lists the objects using the secret and stops instead. Drop them first, or manage them in
+the same configuration so that Terraform destroys them before the secret.
+
+## Import a Secret
+
+A secret created in SQL can be imported with the UUID of its cluster, its database and its name:
+
+` + "```shell" + `
+terraform import risingwavecloud_secret.kafka_password <cluster_id>.<database_name>.<secret_name>
+` + "```" + `
+
+The value of an imported secret is unknown to Terraform. Setting ` + "`" + `value_wo_version` + "`" + ` for the first time
+after an import does not update the secret, so it keeps its current value until the version is
+incremented.
+`
+
+var sqlObjectMarkdownDescription = `
+An object in a database of a RisingWave cluster, e.g. a source, a table, a materialized view or a
+sink, managed with the SQL statements that create and drop it. The provider does not interpret the
+statements, it runs them as they are:
+
+` + "```hcl" + `
+  resource "risingwavecloud_sql_object" "orders" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    database   = risingwavecloud_database.analytics.name
+
+    create_sql = [
+      "CREATE TABLE orders (id BIGINT PRIMARY KEY, amount DECIMAL)",
+    ]
+    destroy_sql = [
+      "DROP TABLE IF EXISTS orders",
+    ]
+    read_sql = "SELECT 1 FROM rw_catalog.rw_tables WHERE name = 'orders'"
+  }
+` + "```" + `
+
+The statements of ` + "`" + `create_sql` + "`" + ` and ` + "`" + `destroy_sql` + "`" + ` run in order in a single batch, and every statement
+that fails is reported with the error the cluster returned. The statements are not run in a
+transaction. If some of them succeed before one fails, the resource is saved as tainted so that the
+next apply runs ` + "`" + `destroy_sql` + "`" + ` before creating the object again; write ` + "`" + `destroy_sql` + "`" + ` so that it also
+cleans up a partially created object, e.g. with ` + "`" + `DROP ... IF EXISTS` + "`" + `.
+
+Changing ` + "`" + `create_sql` + "`" + ` replaces the object. Use ` + "`" + `depends_on` + "`" + `, or reference the other resources in the
+statements, so that objects are created after and dropped before the objects they depend on.
+
+## Drift Detection
+
+Terraform only knows the statements, not the object they create. Set ` + "`" + `read_sql` + "`" + ` to a query that
+returns at least one row while the object exists: if it returns no rows on refresh, the object is
+considered deleted outside of Terraform and is planned to be created again. Changes to the object
+itself, e.g. an altered column, are not detected.
+
+This resource cannot be imported, since the statements that created an existing object are not known.
+`
+
+var materializedViewMarkdownDescription = `
+A materialized view in a database of a RisingWave cluster:
+
+` + "```hcl" + `
+  resource "risingwavecloud_materialized_view" "revenue" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    database   = risingwavecloud_database.analytics.name
+    name       = "revenue_per_minute"
+    definition = <<-SQL
+      SELECT window_start, SUM(amount) AS revenue
+      FROM TUMBLE(orders, created_at, INTERVAL '1 MINUTE')
+      GROUP BY window_start
+    SQL
+
+    backfill_timeout = "2h"
+  }
+` + "```" + `
+
+## Backfill
+
+A new materialized view first backfills the data its upstreams already hold, and only serves
+complete results once that is done. The provider creates the materialized view in the background
+and waits for the backfill to complete, so the apply only finishes once the materialized view is
+usable. The wait is bounded by ` + "`" + `backfill_timeout` + "`" + `. If it expires, the apply fails and the materialized
+view is marked as tainted; either increase ` + "`" + `backfill_timeout` + "`" + `, or run ` + "`" + `terraform untaint` + "`" + ` once the
+backfill completes to keep it.
+
+## Drift
+
+RisingWave normalizes the definition it records, so ` + "`" + `catalog_definition` + "`" + ` is compared instead of
+` + "`" + `definition` + "`" + `. If the recorded definition changes, e.g. because the materialized view was recreated
+outside of Terraform, ` + "`" + `definition` + "`" + ` is refreshed from it and the plan replaces the materialized view with
+the configured one.
+
+~> **Note:** Changing ` + "`" + `definition` + "`" + ` replaces the materialized view, which backfills again from scratch.
+A materialized view read by other materialized views or sinks cannot be deleted, and neither can it be
+replaced; the provider lists the objects reading from it and stops instead.
+
+~> **Note:** Only the ` + "`" + `public` + "`" + ` schema is supported. The API waits for the backfill of a materialized
+view, lists the objects reading from it and drops it by its name alone, so the provider cannot tell
+apart materialized views of the same name in different schemas.
+
+## Import a Materialized View
+
+` + "```shell" + `
+terraform import risingwavecloud_materialized_view.revenue <cluster_id>.<database_name>.<schema>.<name>
+` + "```" + `
+
+The query is taken from the definition RisingWave records, so the configured ` + "`" + `definition` + "`" + ` has to match
+its normalized formatting, or the next apply replaces the materialized view.
+`
+
+var sourceMarkdownDescription = `
+A source in a database of a RisingWave cluster, ingesting data from an upstream system:
+
+` + "```hcl" + `
+  resource "risingwavecloud_secret" "kafka_password" {
+    cluster_id       = risingwavecloud_cluster.mycluster.id
+    database         = risingwavecloud_database.analytics.name
+    name             = "kafka_password"
+    value_wo         = var.kafka_password
+    value_wo_version = 1
+  }
+
+  resource "risingwavecloud_source" "orders" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    database   = risingwavecloud_database.analytics.name
+    name       = "orders"
+    columns    = "id BIGINT, amount DECIMAL, created_at TIMESTAMPTZ"
+    connector  = "kafka"
+    properties = {
+      "topic"                        = "orders"
+      "properties.bootstrap.server"  = "broker-1:9092"
+      "properties.sasl.username"     = "rw"
+      "properties.sasl.mechanism"    = "PLAIN"
+      "properties.security.protocol" = "SASL_SSL"
+    }
+    secret_properties = {
+      "properties.sasl.password" = risingwavecloud_secret.kafka_password.name
+    }
+    format = "PLAIN"
+    encode = "JSON"
+  }
+` + "```" + `
+
+## Credentials
+
+Credentials of the upstream system belong in ` + "`" + `secret_properties` + "`" + `, which references a secret, e.g. a
+` + "`" + `risingwavecloud_secret` + "`" + ` resource, by name. Only the name of the secret ends up in the statement and in the
+state. ` + "`" + `sensitive_properties` + "`" + ` hides values in the plan output, but they are still stored in the state.
+
+## Changes and Drift
+
+A source cannot be altered, so changing any of its attributes replaces it. The properties are read back
+from the platform on refresh; a configured property that was changed outside of Terraform shows up in
+the plan, which replaces the source. The sensitive and secret properties are not compared.
+
+~> **Note:** A source read by materialized views, tables or sinks cannot be deleted, and neither can it
+be replaced; the provider lists the objects reading from it and stops instead.
+
+~> **Note:** Only the ` + "`" + `public` + "`" + ` schema is supported. The API lists the objects reading from a source and
+drops it by its name alone, so the provider cannot tell apart sources of the same name in different
+schemas.
+
+## Import a Source
+
+` + "```shell" + `
+terraform import risingwavecloud_source.orders <cluster_id>.<database_name>.<schema>.<name>
+` + "```" + `
+
+Every property the platform reports is imported into ` + "`" + `properties` + "`" + `, including the defaults. Move the
+credentials to ` + "`" + `secret_properties` + "`" + ` and drop the defaults you do not set from the configuration.
+`
+
+var sinkMarkdownDescription = `
+A sink in a database of a RisingWave cluster, delivering data to a downstream system:
+
+` + "```hcl" + `
+  resource "risingwavecloud_sink" "revenue" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    database   = risingwavecloud_database.analytics.name
+    name       = "revenue_to_kafka"
+    from       = risingwavecloud_materialized_view.revenue.name
+    connector  = "kafka"
+    properties = {
+      "topic"                       = "revenue"
+      "properties.bootstrap.server" = "broker-1:9092"
+    }
+    format = "PLAIN"
+    encode = "JSON"
+    encode_properties = {
+      "force_append_only" = "true"
+    }
+  }
+` + "```" + `
+
+## Credentials
+
+Credentials of the downstream system belong in ` + "`" + `secret_properties` + "`" + `, which references a secret, e.g. a
+` + "`" + `risingwavecloud_secret` + "`" + ` resource, by name. Only the name of the secret ends up in the statement and in the
+state. ` + "`" + `sensitive_properties` + "`" + ` hides values in the plan output, but they are still stored in the state.
+
+## Changes and Drift
+
+A sink cannot be altered, so changing any of its attributes replaces it. The properties are read back
+from the platform on refresh; a configured property that was changed outside of Terraform shows up in
+the plan, which replaces the sink. The sensitive and secret properties are not compared.
+
+~> **Note:** A sink other objects depend on cannot be deleted, and neither can it be replaced; the
+provider lists these objects and stops instead.
+
+~> **Note:** Only the ` + "`" + `public` + "`" + ` schema is supported. The API lists the objects depending on a sink and
+drops it by its name alone, so the provider cannot tell apart sinks of the same name in different
+schemas.
+
+## Import a Sink
+
+` + "```shell" + `
+terraform import risingwavecloud_sink.revenue <cluster_id>.<database_name>.<schema>.<name>
+` + "```" + `
+
+Every property the platform reports is imported into ` + "`" + `properties` + "`" + `, including the defaults, and ` + "`" + `from` + "`" + `
+has to be set in the configuration, or the next apply replaces the sink.
+`
+
+var sourceConnectivityCheckMarkdownDescription = `
+Checks that a RisingWave cluster can connect to the upstream system of a source. The cluster connects
+from inside its own network when the data source is read, so an unreachable broker or database, or wrong
+credentials, fail the plan with the message of the platform instead of the creation of the source:
+
+` + "```hcl" + `
+  data "risingwavecloud_source_connectivity_check" "orders" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    kafka = {
+      bootstrap_server  = "broker-1:9092"
+      security_protocol = "SASL_SSL"
+      sasl_mechanism    = "PLAIN"
+      sasl_username     = "rw"
+      sasl_password     = var.kafka_password
+      topic             = "orders"
+    }
+    list_objects = true
+  }
+
+  resource "risingwavecloud_source" "orders" {
+    depends_on = [data.risingwavecloud_source_connectivity_check.orders]
+    ...
+  }
+` + "```" + `
+
+Exactly one of ` + "`" + `kafka` + "`" + `, ` + "`" + `postgres_cdc` + "`" + `, ` + "`" + `mysql_cdc` + "`" + `, ` + "`" + `sqlserver_cdc` + "`" + ` and ` + "`" + `mongodb_cdc` + "`" + ` must be set.
+With ` + "`" + `list_objects` + "`" + `, the topics, tables or collections the cluster sees are listed; with a Kafka
+` + "`" + `topic` + "`" + `, the columns inferred from its messages are listed in ` + "`" + `columns` + "`" + `.
+
+~> **Note:** The credentials are stored in the state like those of any other data source. If the cluster is
+created in the same apply, the check runs during the apply, once the cluster exists.
+`
+
+var clusterCloudMetadataMarkdownDescription = `
+The metadata of the cloud resources of a RisingWave cluster: the public IPs it connects to the internet
+from, the principals to allow on a PrivateLink service for it to connect to, the PrivateLink service it is
+served through, and the identity its nodes run as. For example, to let a cluster on AWS reach a Kafka
+cluster, both over the internet and through PrivateLink:
+
+` + "```hcl" + `
+  data "risingwavecloud_cluster_cloud_metadata" "mycluster" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+  }
+
+  resource "aws_vpc_security_group_ingress_rule" "kafka" {
+    for_each          = toset(data.risingwavecloud_cluster_cloud_metadata.mycluster.network.aws.nat_gateway_ips)
+    security_group_id = aws_security_group.kafka.id
+    cidr_ipv4         = "${each.value}/32"
+    ip_protocol       = "tcp"
+    from_port         = 9092
+    to_port           = 9092
+  }
+
+  resource "aws_vpc_endpoint_service_allowed_principal" "kafka" {
+    for_each                = toset(data.risingwavecloud_cluster_cloud_metadata.mycluster.network.aws.private_link_principals)
+    vpc_endpoint_service_id = aws_vpc_endpoint_service.kafka.id
+    principal_arn           = each.value
+  }
+` + "```" + `
+
+Only the ` + "`" + `aws` + "`" + ` or the ` + "`" + `gcp` + "`" + ` attributes of ` + "`" + `network` + "`" + ` and ` + "`" + `iam` + "`" + ` are set, depending on the
+cloud provider of the cluster; the others are null.
+`
+
+var clusterEndpointMarkdownDescription = `
+The endpoint to connect to a RisingWave cluster with a Postgres client, along with a connection URI for a
+given user. For example, to configure the ` + "`" + `postgresql` + "`" + ` provider and pass the URI to an application:
+
+` + "```hcl" + `
+  data "risingwavecloud_cluster_endpoint" "mycluster" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+    username   = risingwavecloud_cluster_user.app.username
+  }
+
+  provider "postgresql" {
+    host      = data.risingwavecloud_cluster_endpoint.mycluster.host
+    port      = data.risingwavecloud_cluster_endpoint.mycluster.port
+    database  = data.risingwavecloud_cluster_endpoint.mycluster.database
+    username  = risingwavecloud_cluster_user.app.username
+    password  = var.app_password
+    superuser = false
+  }
+
+  resource "helm_release" "app" {
+    # ...
+    set {
+      name  = "database.uri"
+      value = data.risingwavecloud_cluster_endpoint.mycluster.connection_uri
+    }
+  }
+` + "```" + `
+
+The ` + "`" + `connection_uri` + "`" + ` carries no password, which is best passed to the application separately,
+e.g. in the ` + "`" + `PGPASSWORD` + "`" + ` environment variable. A cluster that is not deployed yet, e.g. a BYOK cluster
+waiting for its IAM role, has no endpoint, and reading the data source fails. The same values, except for
+the connection URI, are in the ` + "`" + `endpoint` + "`" + ` attribute of the ` + "`" + `risingwavecloud_cluster` + "`" + ` resource.
+`
+
+var clusterCACertificateMarkdownDescription = `
+The CA certificate chain to verify the endpoint of a RisingWave cluster with, e.g. to connect with
+` + "`" + `sslmode=verify-full` + "`" + `. Set ` + "`" + `cluster_id` + "`" + ` for the chain of a cluster, or ` + "`" + `region` + "`" + ` for the root CA
+certificate of a region. For example, to mount the chain into the pods of an application:
+
+` + "```hcl" + `
+  data "risingwavecloud_cluster_ca_certificate" "mycluster" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+  }
+
+  resource "kubernetes_secret" "risingwave_ca" {
+    metadata {
+      name = "risingwave-ca"
+    }
+    data = {
+      "ca.crt" = data.risingwavecloud_cluster_ca_certificate.mycluster.pem
+    }
+  }
+` + "```" + `
+
+The certificate chain is read again on every plan, so a rotated chain shows up as a change of the
+secret. ` + "`" + `not_after` + "`" + ` is the earliest expiry in the chain, which a check can alert on ahead of time:
+
+` + "```hcl" + `
+  check "risingwave_ca_expiry" {
+    assert {
+      condition     = timecmp(data.risingwavecloud_cluster_ca_certificate.mycluster.not_after, timeadd(plantimestamp(), "720h")) > 0
+      error_message = "The CA certificate of the cluster expires within 30 days."
+    }
+  }
+` + "```" + `
+`
+
+var clusterOAuthTokenMarkdownDescription = `
+A short-lived OAuth token to connect to a RisingWave cluster with, in place of the password of a
+cluster user. The token is ephemeral: it is issued anew in every Terraform run and is never written to
+the plan or the state. This requires Terraform 1.10 or later. For example, to configure the
+` + "`" + `postgresql` + "`" + ` provider without keeping a password anywhere:
+
+` + "```hcl" + `
+  data "risingwavecloud_cluster_endpoint" "mycluster" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+  }
+
+  ephemeral "risingwavecloud_cluster_oauth_token" "mycluster" {
+    cluster_id = risingwavecloud_cluster.mycluster.id
+  }
+
+  provider "postgresql" {
+    host      = data.risingwavecloud_cluster_endpoint.mycluster.host
+    port      = data.risingwavecloud_cluster_endpoint.mycluster.port
+    database  = data.risingwavecloud_cluster_endpoint.mycluster.database
+    username  = var.username
+    password  = ephemeral.risingwavecloud_cluster_oauth_token.mycluster.token
+    superuser = false
+  }
+` + "```" + `
+
+The token is short-lived, so it only lasts for the run it is issued in. It can be referenced in provider
+configurations, other ephemeral resources and write-only arguments, but not in regular resource
+arguments or outputs.
+`
+
+var serviceAccountMarkdownDescription = `
+A service account of the RisingWave Cloud organization. A service account is a principal that is not a
+person, e.g. a data pipeline or a CI job, which authenticates with the API keys it owns.
+
+The service account is created with the role in ` + "`" + `role_id` + "`" + `. Only the description can be updated in
+place: changing the name or the role replaces the service account.
+
+## Import a Service Account
+
+To import a service account, follow the steps below:
+
+1. Get the UUID of the service account from the RisingWave Cloud console.
+
+2. Write a resource definition to import the service account. For example:
+
+` + "```hcl" + `
+  resource "risingwavecloud_service_account" "pipeline" {
+    name    = "pipeline-orders"
+    role_id = "<role_id>"
+  }
+  ` + "```" + `
+
+3. Run the import command:
+
+` + "```shell" + `
+terraform import risingwavecloud_service_account.pipeline <service_account_id>
+` + "```" + `
+
+The role of a service account is not read back, so the ` + "`" + `role_id` + "`" + ` in the configuration is taken as is
+after the import, without replacing the service account.
+`
+
+var apiKeyMarkdownDescription = `
+An API key of a RisingWave Cloud service account, e.g. the credentials of the provider in a CI
+pipeline. Only the description can be updated in place.
+
+The platform returns the secret of the key only once, when the key is created. The provider keeps it
+in ` + "`" + `secret` + "`" + `, which is stored in the state file in plain text.
+[Read more about sensitive data in state](https://www.terraform.io/docs/state/sensitive-data.html).
+To keep it out of the state of the consumers, hand it on through a
+[write-only argument](https://developer.hashicorp.com/terraform/language/manage-sensitive-data/write-only),
+e.g. into a secret manager:
+
+` + "```hcl" + `
+  resource "risingwavecloud_service_account" "ci" {
+    name    = "ci"
+    role_id = var.project_admin_role_id
+  }
+
+  resource "time_rotating" "monthly" {
+    rotation_months = 1
+  }
+
+  resource "risingwavecloud_api_key" "ci" {
+    service_account_id = risingwavecloud_service_account.ci.id
+    description        = "CI provider credentials"
+    rotation_trigger   = time_rotating.monthly.id
+  }
+
+  resource "aws_secretsmanager_secret_version" "ci" {
+    secret_id = aws_secretsmanager_secret.ci.id
+    secret_string_wo = jsonencode({
+      RWC_API_KEY    = risingwavecloud_api_key.ci.key
+      RWC_API_SECRET = risingwavecloud_api_key.ci.secret
+    })
+    secret_string_wo_version = parseint(risingwavecloud_api_key.ci.id, 10)
+  }
+` + "```" + `
+
+## Rotating the key
+
+Changing ` + "`" + `rotation_trigger` + "`" + ` rotates the key: the provider creates a new key first, records it in the
+state and then deletes the previous one, so there is no point in time without a valid key. The ID of
+the key changes with every rotation. Setting ` + "`" + `rotation_trigger` + "`" + ` on a key that has none, or removing
+it, does not rotate the key.
+
+~> **Note:** The previous key stops working as soon as the rotation is applied. Whatever uses it has to
+pick up the new one in the same apply, e.g. from the secret manager above.
+
+## Import an API Key
+
+To import an API key, follow the steps below:
+
+1. Get the ID of the API key from the RisingWave Cloud console.
+
+2. Write a resource definition to import the API key. For example:
+
+` + "```hcl" + `
+  resource "risingwavecloud_api_key" "ci" {
+    service_account_id = risingwavecloud_service_account.ci.id
+  }
+  ` + "```" + `
+
+3. Run the import command:
+
+` + "```shell" + `
+terraform import risingwavecloud_api_key.ci <api_key_id>
+` + "```" + `
+
+The secret of an imported key cannot be read, so ` + "`" + `secret` + "`" + ` is null until the key is rotated.
+`
+
+var roleBindingsMarkdownDescription = `
+All role bindings of the RisingWave Cloud organization, i.e. which principal, a user or a service
+account, has which role. Keeping them in one resource puts the organization's access control under
+review in version control, and the plan lists every binding that an apply adds or removes.
+
+!> **This resource owns the whole set.** A role bound anywhere else, in the console for example, is
+unbound on the next apply, and a principal missing from ` + "`" + `bindings` + "`" + ` loses all of its roles. That
+includes the service account Terraform runs as: keep its binding in the set, or the apply locks
+Terraform out of the organization. Import the existing bindings first and start from them.
+
+Do not combine this resource with ` + "`" + `risingwavecloud_role_binding` + "`" + `, which binds single roles and
+leaves the rest to others: each would undo the other's changes.
+
+~> **Note:** The bindings are updated one principal at a time. Principals that only gain roles are
+updated first, so that no principal loses a role it still needs to finish the apply. Destroying the
+resource only removes it from the state, the bindings are kept.
+
+## Import the role bindings of the organization
+
+The organization has one set of role bindings, so the ID is always ` + "`" + `org` + "`" + `:
+
+` + "```shell" + `
+terraform import risingwavecloud_role_bindings.org org
+` + "```" + `
+
+The state then holds every binding of the organization, including the principal types the API
+reports, which the configuration has to repeat.
+`
+
+var roleBindingMarkdownDescription = `
+A role bound to a principal of the RisingWave Cloud organization, a user or a service account. The
+other roles of the principal, and the bindings of other principals, are left as they are, so that a
+team can manage the bindings it owns next to those managed elsewhere.
+
+Do not combine this resource with ` + "`" + `risingwavecloud_role_bindings` + "`" + `, which owns all bindings of the
+organization.
+
+## Import a Role Binding
+
+The role binding is identified by the principal and the role, joined by a dot:
+
+` + "```shell" + `
+terraform import risingwavecloud_role_binding.pipeline <principal>.<role_id>
+` + "```" + `
+`
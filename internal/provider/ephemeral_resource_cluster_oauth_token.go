@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ClusterOAuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ClusterOAuthTokenEphemeralResource{}
+
+func NewClusterOAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ClusterOAuthTokenEphemeralResource{}
+}
+
+// ClusterOAuthTokenEphemeralResource issues a short-lived OAuth token to connect to a cluster
+// with. Being ephemeral, the token is never written to the plan or the state.
+type ClusterOAuthTokenEphemeralResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterOAuthTokenModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Token     types.String `tfsdk:"token"`
+}
+
+func (r *ClusterOAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_oauth_token"
+}
+
+func (r *ClusterOAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A short-lived OAuth token to connect to a RisingWave cluster with.",
+		MarkdownDescription: clusterOAuthTokenMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The OAuth token. A new token is issued in every Terraform run.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ClusterOAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterOAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ClusterOAuthTokenModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	token, err := r.client.CreateClusterOAuthToken(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create an OAuth token for the cluster", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterOAuthTokenOpen(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		expect      func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError string
+	}{
+		{
+			name: "token",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().CreateClusterOAuthToken(gomock.Any(), nsID).Return("eyJhbGciOiJSUzI1NiJ9.e30.c2ln", nil)
+			},
+		},
+		{
+			name: "cluster not found",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().CreateClusterOAuthToken(gomock.Any(), nsID).Return("", errors.Wrap(cloudsdk.ErrClusterNotFound, "cluster not found"))
+			},
+			expectError: "cluster not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &ClusterOAuthTokenEphemeralResource{client: client}
+
+			schemaResp := ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+				"token":      tftypes.NewValue(tftypes.String, nil),
+			})
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: raw},
+			}
+			r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, resp)
+			if tt.expectError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.expectError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data ClusterOAuthTokenModel
+			require.False(t, resp.Result.Get(ctx, &data).HasError())
+			assert.Equal(t, ClusterOAuthTokenModel{
+				ClusterID: types.StringValue(nsID.String()),
+				Token:     types.StringValue("eyJhbGciOiJSUzI1NiJ9.e30.c2ln"),
+			}, data)
+		})
+	}
+}
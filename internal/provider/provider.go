@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,7 @@ const (
 
 // Assert the provider satisfies various provider interfaces.
 var _ provider.Provider = &RisingWaveCloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &RisingWaveCloudProvider{}
 
 type RisingWaveCloudProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -146,20 +148,48 @@ func (p *RisingWaveCloudProvider) Configure(ctx context.Context, req provider.Co
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *RisingWaveCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewClusterResource,
+		NewBYOCEnvironmentResource,
+		NewBYOKEnvironmentResource,
 		NewClusterUserResource,
 		NewPrivateLinkResource,
 		NewClusterResourceGroupResource,
 		NewClusterAllowedIamRolesResource,
+		NewClusterBackupResource,
+		NewClusterInPlaceRestoreResource,
+		NewClusterIcebergCompactionResource,
+		NewClusterServerlessBackfillResource,
+		NewDatabaseResource,
+		NewSecretResource,
+		NewSQLObjectResource,
+		NewMaterializedViewResource,
+		NewSourceResource,
+		NewSinkResource,
+		NewServiceAccountResource,
+		NewAPIKeyResource,
+		NewRoleBindingsResource,
+		NewRoleBindingResource,
 	}
 }
 
 func (p *RisingWaveCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSourceConnectivityCheckDataSource,
+		NewClusterCloudMetadataDataSource,
+		NewClusterEndpointDataSource,
+		NewClusterCACertificateDataSource,
+	}
+}
+
+func (p *RisingWaveCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewClusterOAuthTokenEphemeralResource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &APIKeyResource{}
+var _ resource.ResourceWithImportState = &APIKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &APIKeyResource{}
+}
+
+type APIKeyResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type APIKeyModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Description      types.String `tfsdk:"description"`
+	RotationTrigger  types.String `tfsdk:"rotation_trigger"`
+	Key              types.String `tfsdk:"key"`
+	Secret           types.String `tfsdk:"secret"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "An API key of a RisingWave Cloud service account.",
+		MarkdownDescription: apiKeyMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the API key. It changes when the key is rotated.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the service account owning the API key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the API key.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, changing it rotates the API key: a new key is created " +
+					"first and the previous one is deleted after. Setting or removing the attribute does not rotate the key.",
+				Optional: true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The API key, i.e. the `api_key` of the provider configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret of the API key, i.e. the `api_secret` of the provider configuration. " +
+					"The platform only returns it when the key is created, it is null for an imported key.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the API key was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// apiKeyRotationRequested reports whether the plan rotates the key, i.e. changes a trigger that is
+// set. Setting the trigger of an existing key, e.g. after an import, does not rotate it. An unknown
+// trigger is taken as a rotation since the plan cannot tell.
+func apiKeyRotationRequested(plan, state types.String) bool {
+	return !plan.IsNull() && !state.IsNull() && !plan.Equal(state)
+}
+
+// ModifyPlan marks the attributes of a new key as unknown when the key is rotated, the plan
+// modifiers of the attributes would keep the ones of the previous key otherwise.
+func (r *APIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to rotate on create and destroy.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state APIKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apiKeyRotationRequested(plan.RotationTrigger, state.RotationTrigger) {
+		return
+	}
+	for _, name := range []string{"id", "key", "secret", "created_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+}
+
+func apiKeyToDataModel(key *apigen_accv2.ApiKey, data *APIKeyModel) {
+	data.ID = types.StringValue(strconv.FormatUint(key.Id, 10))
+	data.ServiceAccountID = types.StringValue(key.Principal.String())
+	data.Description = types.StringValue(key.Description)
+	data.Key = types.StringValue(key.Key)
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+}
+
+func createdAPIKeyToDataModel(key *apigen_accv2.CreatedApiKey, data *APIKeyModel) {
+	apiKeyToDataModel(&apigen_accv2.ApiKey{
+		Id:          key.Id,
+		Key:         key.Key,
+		Principal:   key.Principal,
+		Description: key.Description,
+		CreatedAt:   key.CreatedAt,
+		UpdatedAt:   key.UpdatedAt,
+	}, data)
+	data.Secret = types.StringValue(key.Secret)
+}
+
+func parseAPIKeyID(id string, diags *diag.Diagnostics) uint64 {
+	keyID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse API key ID: %s", id))
+	}
+	return keyID
+}
+
+func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal, err := uuid.Parse(data.ServiceAccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("service_account_id"), "service_account_id is invalid", fmt.Sprintf("Cannot parse service account ID %s", data.ServiceAccountID.String()))
+		return
+	}
+
+	key, err := r.client.CreateAPIKey(ctx, principal, data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create API key", err.Error())
+		return
+	}
+
+	createdAPIKeyToDataModel(key, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("API key created, ID: %d", key.Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := parseAPIKeyID(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrAPIKeyNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("API key %d not found, removing it from the state", id))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read API key", err.Error())
+		return
+	}
+
+	// the secret is not returned anymore, it stays as it was created.
+	apiKeyToDataModel(key, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data  APIKeyModel
+		state APIKeyModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := parseAPIKeyID(state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apiKeyRotationRequested(data.RotationTrigger, state.RotationTrigger) {
+		// the trigger is only recorded, the description is the one thing to update in place.
+		data.ID, data.Key, data.Secret, data.CreatedAt = state.ID, state.Key, state.Secret, state.CreatedAt
+		if !data.Description.Equal(state.Description) {
+			key, err := r.client.UpdateAPIKeyDescription(ctx, id, data.Description.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to update API key", err.Error())
+				return
+			}
+			apiKeyToDataModel(key, &data)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	principal, err := uuid.Parse(data.ServiceAccountID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("service_account_id"), "service_account_id is invalid", fmt.Sprintf("Cannot parse service account ID %s", data.ServiceAccountID.String()))
+		return
+	}
+
+	// create the new key before deleting the previous one, so that whatever uses the key can
+	// switch over in between.
+	key, err := r.client.CreateAPIKey(ctx, principal, data.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to rotate API key", err.Error())
+		return
+	}
+	createdAPIKeyToDataModel(key, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("API key %d rotated, new ID: %d", id, key.Id))
+
+	// record the new key first, its secret cannot be read again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete the previous API key",
+			fmt.Sprintf("The new API key %d is in the state, delete the previous API key %d in the console: %s", key.Id, id, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := parseAPIKeyID(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Unable to delete API key", err.Error())
+		return
+	}
+}
+
+func (r *APIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := parseAPIKeyID(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetAPIKey(ctx, id); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import API key with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyRotationRequested(t *testing.T) {
+	assert.False(t, apiKeyRotationRequested(types.StringValue("2026-10"), types.StringValue("2026-10")))
+	assert.True(t, apiKeyRotationRequested(types.StringValue("2026-11"), types.StringValue("2026-10")))
+	assert.True(t, apiKeyRotationRequested(types.StringUnknown(), types.StringValue("2026-10")))
+	// setting the trigger of an existing key or removing it does not rotate the key.
+	assert.False(t, apiKeyRotationRequested(types.StringValue("2026-10"), types.StringNull()))
+	assert.False(t, apiKeyRotationRequested(types.StringNull(), types.StringValue("2026-10")))
+}
+
+func TestAPIKeyUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		principal = uuid.Must(uuid.NewRandom())
+		createdAt = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+		rotatedAt = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	r := &APIKeyResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "41"),
+		"service_account_id": tftypes.NewValue(tftypes.String, principal.String()),
+		"description":        tftypes.NewValue(tftypes.String, "CI"),
+		"rotation_trigger":   tftypes.NewValue(tftypes.String, "2026-09"),
+		"key":                tftypes.NewValue(tftypes.String, "old-key"),
+		"secret":             tftypes.NewValue(tftypes.String, "old-secret"),
+		"created_at":         tftypes.NewValue(tftypes.String, createdAt.Format(time.RFC3339)),
+	})}
+	plan := func(description, trigger string) tfsdk.Plan {
+		return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"service_account_id": tftypes.NewValue(tftypes.String, principal.String()),
+			"description":        tftypes.NewValue(tftypes.String, description),
+			"rotation_trigger":   tftypes.NewValue(tftypes.String, trigger),
+			"key":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"secret":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"created_at":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})}
+	}
+	newKey := &apigen_accv2.CreatedApiKey{
+		Id:          42,
+		Key:         "new-key",
+		Secret:      "new-secret",
+		Principal:   principal,
+		Description: "CI",
+		CreatedAt:   rotatedAt,
+		UpdatedAt:   rotatedAt,
+	}
+
+	tests := []struct {
+		name         string
+		plan         tfsdk.Plan
+		expect       func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError  string
+		expectID     uint64
+		expectKey    string
+		expectSecret string
+	}{
+		{
+			name: "description",
+			plan: plan("CI credentials", "2026-09"),
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().UpdateAPIKeyDescription(gomock.Any(), uint64(41), "CI credentials").Return(&apigen_accv2.ApiKey{
+					Id:          41,
+					Key:         "old-key",
+					Principal:   principal,
+					Description: "CI credentials",
+					CreatedAt:   createdAt,
+				}, nil)
+			},
+			expectID:     41,
+			expectKey:    "old-key",
+			expectSecret: "old-secret",
+		},
+		{
+			name: "rotation",
+			plan: plan("CI", "2026-10"),
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				gomock.InOrder(
+					client.EXPECT().CreateAPIKey(gomock.Any(), principal, "CI").Return(newKey, nil),
+					client.EXPECT().DeleteAPIKey(gomock.Any(), uint64(41)).Return(nil),
+				)
+			},
+			expectID:     42,
+			expectKey:    "new-key",
+			expectSecret: "new-secret",
+		},
+		{
+			name: "rotation fails to delete the previous key",
+			plan: plan("CI", "2026-10"),
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				gomock.InOrder(
+					client.EXPECT().CreateAPIKey(gomock.Any(), principal, "CI").Return(newKey, nil),
+					client.EXPECT().DeleteAPIKey(gomock.Any(), uint64(41)).Return(errors.New("internal error")),
+				)
+			},
+			expectError:  "delete the previous API key 41",
+			expectID:     42,
+			expectKey:    "new-key",
+			expectSecret: "new-secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &APIKeyResource{client: client}
+			resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			r.Update(ctx, resource.UpdateRequest{Plan: tt.plan, State: state}, resp)
+			if tt.expectError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.expectError)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			}
+
+			// the new key is in the state either way, its secret cannot be read again.
+			var data APIKeyModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, strconv.FormatUint(tt.expectID, 10), data.ID.ValueString())
+			assert.Equal(t, tt.expectKey, data.Key.ValueString())
+			assert.Equal(t, tt.expectSecret, data.Secret.ValueString())
+		})
+	}
+}
+
+func TestAPIKeyModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	principal := uuid.Must(uuid.NewRandom())
+
+	r := &APIKeyResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	value := func(trigger string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, "41"),
+			"service_account_id": tftypes.NewValue(tftypes.String, principal.String()),
+			"description":        tftypes.NewValue(tftypes.String, "CI"),
+			"rotation_trigger":   tftypes.NewValue(tftypes.String, trigger),
+			"key":                tftypes.NewValue(tftypes.String, "old-key"),
+			"secret":             tftypes.NewValue(tftypes.String, "old-secret"),
+			"created_at":         tftypes.NewValue(tftypes.String, "2026-09-01T00:00:00Z"),
+		})
+	}
+
+	for name, tt := range map[string]struct {
+		trigger       string
+		expectUnknown bool
+	}{
+		"unchanged": {trigger: "2026-09"},
+		"rotated":   {trigger: "2026-10", expectUnknown: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(tt.trigger)}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: value("2026-09")},
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data APIKeyModel
+			require.False(t, resp.Plan.Get(ctx, &data).HasError())
+			assert.Equal(t, tt.expectUnknown, data.ID.IsUnknown())
+			assert.Equal(t, tt.expectUnknown, data.Secret.IsUnknown())
+			assert.Equal(t, "CI", data.Description.ValueString())
+		})
+	}
+}
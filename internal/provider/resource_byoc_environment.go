@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BYOCEnvironmentResource{}
+var _ resource.ResourceWithImportState = &BYOCEnvironmentResource{}
+
+func NewBYOCEnvironmentResource() resource.Resource {
+	return &BYOCEnvironmentResource{}
+}
+
+// BYOCEnvironmentResource manages a BYOC environment, which the API calls a BYOC cluster: the
+// infrastructure in the cloud account of the customer that BYOC clusters run on.
+type BYOCEnvironmentResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type BYOCEnvironmentModel struct {
+	// [region].[name]
+	ID       types.String `tfsdk:"id"`
+	Region   types.String `tfsdk:"region"`
+	Name     types.String `tfsdk:"name"`
+	Settings types.Map    `tfsdk:"settings"`
+	Version  types.String `tfsdk:"version"`
+	Status   types.String `tfsdk:"status"`
+}
+
+func (r *BYOCEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_byoc_environment"
+}
+
+func (r *BYOCEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A BYOC (Bring Your Own Cloud) environment, the infrastructure BYOC clusters run on.",
+		MarkdownDescription: byocEnvironmentMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [region].[name]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the environment.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment. Clusters run in it by setting `byoc.env` to this name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "The settings of the environment, e.g. the cloud account and the network to " +
+					"provision it in. Only the settings in the configuration are managed: the ones the platform adds " +
+					"are left as they are and do not show up in the plan.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the environment. The platform picks one if not set. Changing it " +
+					"upgrades the environment in place. The platform does not report the version, so it is not " +
+					"read back.",
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the environment reported by the platform, e.g. `Ready`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BYOCEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// byocEnvironmentToDataModel fills the model from the environment. The settings and the version
+// are left as they are.
+func byocEnvironmentToDataModel(region string, cluster *apigen_mgmtv2.ManagedCluster, data *BYOCEnvironmentModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", region, cluster.Name))
+	data.Region = types.StringValue(region)
+	data.Name = types.StringValue(cluster.Name)
+	data.Status = types.StringValue(string(cluster.Status))
+}
+
+// managedBYOCSettings returns the settings of the environment whose keys are in managed, i.e. the
+// settings in the state.
+func managedBYOCSettings(ctx context.Context, cluster *apigen_mgmtv2.ManagedCluster, managed types.Map, diags *diag.Diagnostics) types.Map {
+	if managed.IsNull() || managed.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+	settings := map[string]string{}
+	for key := range managed.Elements() {
+		if value, ok := cluster.Settings[key]; ok {
+			settings[key] = value
+		}
+	}
+	value, valueDiags := types.MapValueFrom(ctx, types.StringType, settings)
+	diags.Append(valueDiags...)
+	return value
+}
+
+// parseEnvironmentIdentifier splits the `[region].[name]` of a BYOC or BYOK environment. Region
+// names do not contain dots.
+func parseEnvironmentIdentifier(id string, diags *diag.Diagnostics) (region, name string) {
+	region, name, ok := strings.Cut(id, ".")
+	if !ok || region == "" || name == "" {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse environment ID: %s, expected format: [region].[name]", id))
+	}
+	return region, name
+}
+
+func (r *BYOCEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BYOCEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		region = data.Region.ValueString()
+		name   = data.Name.ValueString()
+	)
+
+	settings, _ := sortedStringMap(ctx, data.Settings, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.client.CreateBYOCCluster(ctx, region, apigen_mgmtv2.PostByocClustersRequestBody{
+		Name:     name,
+		Settings: settings,
+		Version:  data.Version.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create BYOC environment", err.Error())
+		return
+	}
+
+	// record the environment first so a failed wait taints it instead of leaking it.
+	byocEnvironmentToDataModel(region, cluster, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err = r.client.WaitBYOCClusterReady(ctx, region, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create BYOC environment", err.Error())
+		return
+	}
+
+	byocEnvironmentToDataModel(region, cluster, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("BYOC environment created, ID: %s", data.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOCEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BYOCEnvironmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, name := parseEnvironmentIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.client.GetBYOCCluster(ctx, region, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrBYOCClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("BYOC environment %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read BYOC environment", err.Error())
+		return
+	}
+
+	byocEnvironmentToDataModel(region, cluster, &data)
+	data.Settings = managedBYOCSettings(ctx, cluster, data.Settings, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOCEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BYOCEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		region = data.Region.ValueString()
+		name   = data.Name.ValueString()
+	)
+
+	if !data.Settings.Equal(state.Settings) {
+		planned, _ := sortedStringMap(ctx, data.Settings, &resp.Diagnostics)
+		managed, _ := sortedStringMap(ctx, state.Settings, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		cluster, err := r.client.GetBYOCCluster(ctx, region, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read BYOC environment", err.Error())
+			return
+		}
+
+		// the request replaces all the settings: keep the ones not managed here, drop the ones
+		// removed from the configuration.
+		settings := map[string]string{}
+		for key, value := range cluster.Settings {
+			if _, ok := managed[key]; !ok {
+				settings[key] = value
+			}
+		}
+		for key, value := range planned {
+			settings[key] = value
+		}
+
+		if err := r.client.UpdateBYOCCluster(ctx, region, name, apigen_mgmtv2.PutByocClusterRequestBody{
+			Name:     name,
+			Settings: settings,
+			Status:   cluster.Status,
+		}); err != nil {
+			resp.Diagnostics.AddError("Unable to update BYOC environment", err.Error())
+			return
+		}
+	}
+
+	// removing the version leaves the environment on the one it runs.
+	if !data.Version.IsNull() && !data.Version.Equal(state.Version) {
+		if err := r.client.UpdateBYOCClusterVersionAwait(ctx, region, name, data.Version.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Unable to upgrade BYOC environment", err.Error())
+			return
+		}
+	}
+
+	cluster, err := r.client.GetBYOCCluster(ctx, region, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read BYOC environment", err.Error())
+		return
+	}
+	byocEnvironmentToDataModel(region, cluster, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOCEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BYOCEnvironmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, name := parseEnvironmentIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBYOCClusterAwait(ctx, region, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete BYOC environment", err.Error())
+		return
+	}
+}
+
+func (r *BYOCEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	region, name := parseEnvironmentIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetBYOCCluster(ctx, region, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import BYOC environment with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
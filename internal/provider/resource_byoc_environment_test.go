@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBYOCEnvironmentUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	remote := &apigen_mgmtv2.ManagedCluster{
+		Name: "prod",
+		Settings: map[string]string{
+			"uuid":    "6c5b1b7e-0d5c-4b8e-9a43-1c8f1a2d3e4f",
+			"network": "vpc-1",
+			"subnet":  "subnet-1",
+		},
+		Status: apigen_mgmtv2.ClusterStatusReady,
+	}
+
+	tests := []struct {
+		name          string
+		planSettings  map[string]string
+		planVersion   interface{}
+		expect        func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectVersion interface{}
+	}{
+		{
+			name:         "change and remove settings",
+			planSettings: map[string]string{"network": "vpc-2"},
+			planVersion:  "v1",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetBYOCCluster(gomock.Any(), "us-east-1", "prod").Return(remote, nil)
+				client.EXPECT().UpdateBYOCCluster(gomock.Any(), "us-east-1", "prod", apigen_mgmtv2.PutByocClusterRequestBody{
+					Name: "prod",
+					// the setting of the platform is kept, the one removed from the configuration is dropped.
+					Settings: map[string]string{
+						"uuid":    "6c5b1b7e-0d5c-4b8e-9a43-1c8f1a2d3e4f",
+						"network": "vpc-2",
+					},
+					Status: apigen_mgmtv2.ClusterStatusReady,
+				}).Return(nil)
+				client.EXPECT().GetBYOCCluster(gomock.Any(), "us-east-1", "prod").Return(remote, nil)
+			},
+			expectVersion: "v1",
+		},
+		{
+			name:         "upgrade",
+			planSettings: map[string]string{"network": "vpc-1", "subnet": "subnet-1"},
+			planVersion:  "v2",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().UpdateBYOCClusterVersionAwait(gomock.Any(), "us-east-1", "prod", "v2").Return(nil)
+				client.EXPECT().GetBYOCCluster(gomock.Any(), "us-east-1", "prod").Return(remote, nil)
+			},
+			expectVersion: "v2",
+		},
+		{
+			name:         "version removed",
+			planSettings: map[string]string{"network": "vpc-1", "subnet": "subnet-1"},
+			planVersion:  nil,
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetBYOCCluster(gomock.Any(), "us-east-1", "prod").Return(remote, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &BYOCEnvironmentResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			value := func(settings map[string]string, version, status interface{}) tftypes.Value {
+				elements := map[string]tftypes.Value{}
+				for k, v := range settings {
+					elements[k] = tftypes.NewValue(tftypes.String, v)
+				}
+				return tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":       tftypes.NewValue(tftypes.String, "us-east-1.prod"),
+					"region":   tftypes.NewValue(tftypes.String, "us-east-1"),
+					"name":     tftypes.NewValue(tftypes.String, "prod"),
+					"settings": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements),
+					"version":  tftypes.NewValue(tftypes.String, version),
+					"status":   tftypes.NewValue(tftypes.String, status),
+				})
+			}
+			state := value(map[string]string{"network": "vpc-1", "subnet": "subnet-1"}, "v1", "Ready")
+			plan := value(tt.planSettings, tt.planVersion, tftypes.UnknownValue)
+
+			resp := &resource.UpdateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			r.Update(ctx, resource.UpdateRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data BYOCEnvironmentModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, "Ready", data.Status.ValueString())
+			if tt.expectVersion == nil {
+				assert.True(t, data.Version.IsNull())
+			} else {
+				assert.Equal(t, tt.expectVersion, data.Version.ValueString())
+			}
+		})
+	}
+}
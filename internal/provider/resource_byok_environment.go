@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BYOKEnvironmentResource{}
+var _ resource.ResourceWithImportState = &BYOKEnvironmentResource{}
+
+func NewBYOKEnvironmentResource() resource.Resource {
+	return &BYOKEnvironmentResource{}
+}
+
+// BYOKEnvironmentResource manages a BYOK environment, which the API calls a BYOK cluster: a
+// Kubernetes cluster of the customer that BYOK clusters run on.
+type BYOKEnvironmentResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type BYOKEnvironmentModel struct {
+	// [region].[name]
+	ID      types.String `tfsdk:"id"`
+	Region  types.String `tfsdk:"region"`
+	Name    types.String `tfsdk:"name"`
+	Config  types.String `tfsdk:"config"`
+	Version types.String `tfsdk:"version"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (r *BYOKEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_byok_environment"
+}
+
+func (r *BYOKEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A BYOK (Bring Your Own Kubernetes) environment, the Kubernetes cluster BYOK clusters run on.",
+		MarkdownDescription: byokEnvironmentMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [region].[name]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the environment.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment. Clusters run in it by setting `byok.env` to this name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The BYOK configuration of the environment in YAML, e.g. the Kubernetes cluster " +
+					"and the object storage to use. It is only sent when the environment is created, so changing it " +
+					"replaces the environment. The platform does not report it, so it is not read back.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the environment. The platform picks one if not set. Changing it " +
+					"upgrades the environment in place. The platform does not report the version, so it is not " +
+					"read back.",
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the environment reported by the platform, e.g. `Ready`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BYOKEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// byokEnvironmentToDataModel fills the model from the environment. The config and the version
+// are left as they are.
+func byokEnvironmentToDataModel(region string, cluster *apigen_mgmtv2.ManagedCluster, data *BYOKEnvironmentModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", region, cluster.Name))
+	data.Region = types.StringValue(region)
+	data.Name = types.StringValue(cluster.Name)
+	data.Status = types.StringValue(string(cluster.Status))
+}
+
+func (r *BYOKEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BYOKEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		region = data.Region.ValueString()
+		name   = data.Name.ValueString()
+	)
+
+	createReq := apigen_mgmtv2.PostByokClustersRequestBody{
+		Name:    name,
+		Version: data.Version.ValueStringPointer(),
+	}
+	if !data.Config.IsNull() {
+		config := base64.StdEncoding.EncodeToString([]byte(data.Config.ValueString()))
+		createReq.Config = &config
+	}
+
+	cluster, err := r.client.CreateBYOKCluster(ctx, region, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create BYOK environment", err.Error())
+		return
+	}
+
+	// record the environment first so a failed wait taints it instead of leaking it.
+	byokEnvironmentToDataModel(region, cluster, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err = r.client.WaitBYOKClusterReady(ctx, region, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create BYOK environment", err.Error())
+		return
+	}
+
+	byokEnvironmentToDataModel(region, cluster, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("BYOK environment created, ID: %s", data.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOKEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BYOKEnvironmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, name := parseEnvironmentIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := r.client.GetBYOKCluster(ctx, region, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrBYOKClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("BYOK environment %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read BYOK environment", err.Error())
+		return
+	}
+
+	byokEnvironmentToDataModel(region, cluster, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOKEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state BYOKEnvironmentModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		region = data.Region.ValueString()
+		name   = data.Name.ValueString()
+	)
+
+	// removing the version leaves the environment on the one it runs.
+	if !data.Version.IsNull() && !data.Version.Equal(state.Version) {
+		if err := r.client.UpdateBYOKClusterVersionAwait(ctx, region, name, data.Version.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Unable to upgrade BYOK environment", err.Error())
+			return
+		}
+	}
+
+	cluster, err := r.client.GetBYOKCluster(ctx, region, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read BYOK environment", err.Error())
+		return
+	}
+	byokEnvironmentToDataModel(region, cluster, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BYOKEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BYOKEnvironmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, name := parseEnvironmentIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBYOKClusterAwait(ctx, region, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete BYOK environment", err.Error())
+		return
+	}
+}
+
+func (r *BYOKEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	region, name := parseEnvironmentIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetBYOKCluster(ctx, region, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import BYOK environment with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -12,16 +13,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
 	apigen_mgmtv1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/rwcloud"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/wait"
 )
@@ -30,9 +37,16 @@ var (
 	DefaultComputeFileCacheSizeGB = 20
 )
 
+// The values of the power_state attribute of a cluster.
+const (
+	PowerStateRunning = "running"
+	PowerStateStopped = "stopped"
+)
+
 // Assert provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ClusterResource{}
 var _ resource.ResourceWithImportState = &ClusterResource{}
+var _ resource.ResourceWithModifyPlan = &ClusterResource{}
 
 func NewClusterResource() resource.Resource {
 	return &ClusterResource{
@@ -91,6 +105,16 @@ type StandaloneSpecModel struct {
 
 var standaloneAttrTypes = componentAttrTypes
 
+type ComputeCacheModel struct {
+	SizeGB          types.Int64  `tfsdk:"size_gb"`
+	PerformanceTier types.String `tfsdk:"performance_tier"`
+}
+
+var computeCacheAttrTypes = map[string]attr.Type{
+	"size_gb":          types.Int64Type,
+	"performance_tier": types.StringType,
+}
+
 type ClusterSpecModel struct {
 	ComputeSpec      types.Object `tfsdk:"compute"`
 	CompactorSpec    types.Object `tfsdk:"compactor"`
@@ -99,6 +123,7 @@ type ClusterSpecModel struct {
 	StandaloneSpec   types.Object `tfsdk:"standalone"`
 	MetaStoreType    types.String `tfsdk:"metastore_type"`
 	RisingWaveConfig types.String `tfsdk:"risingwave_config"`
+	ComputeCache     types.Object `tfsdk:"compute_cache"`
 }
 
 var clusterSpecAttrTypes = map[string]attr.Type{
@@ -119,6 +144,9 @@ var clusterSpecAttrTypes = map[string]attr.Type{
 	},
 	"metastore_type":    types.StringType,
 	"risingwave_config": types.StringType,
+	"compute_cache": types.ObjectType{
+		AttrTypes: computeCacheAttrTypes,
+	},
 }
 
 type BYOCModel struct {
@@ -131,15 +159,121 @@ var byocAttrTypes = map[string]attr.Type{
 	"encoded_id": types.StringType,
 }
 
+type BYOKModel struct {
+	Env        types.String `tfsdk:"env"`
+	IamRoleArn types.String `tfsdk:"iam_role_arn"`
+	Metastore  types.Object `tfsdk:"metastore"`
+}
+
+type BYOKMetastoreModel struct {
+	Host              types.String `tfsdk:"host"`
+	Port              types.Int64  `tfsdk:"port"`
+	Database          types.String `tfsdk:"database"`
+	Username          types.String `tfsdk:"username"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+var byokMetastoreAttrTypes = map[string]attr.Type{
+	"host":                types.StringType,
+	"port":                types.Int64Type,
+	"database":            types.StringType,
+	"username":            types.StringType,
+	"password_wo":         types.StringType,
+	"password_wo_version": types.Int64Type,
+}
+
+var byokAttrTypes = map[string]attr.Type{
+	"env":          types.StringType,
+	"iam_role_arn": types.StringType,
+	"metastore": types.ObjectType{
+		AttrTypes: byokMetastoreAttrTypes,
+	},
+}
+
+type RestoreFromModel struct {
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+}
+
+var restoreFromAttrTypes = map[string]attr.Type{
+	"cluster_id":  types.StringType,
+	"snapshot_id": types.StringType,
+}
+
+type MaintenanceWindowModel struct {
+	Day          types.Int64  `tfsdk:"day"`
+	Hour         types.Int64  `tfsdk:"hour"`
+	Minute       types.Int64  `tfsdk:"minute"`
+	DurationMins types.Int64  `tfsdk:"duration_mins"`
+	ManualOnly   types.Bool   `tfsdk:"manual_only"`
+	DisableUntil types.String `tfsdk:"disable_until"`
+}
+
+var maintenanceWindowAttrTypes = map[string]attr.Type{
+	"day":           types.Int64Type,
+	"hour":          types.Int64Type,
+	"minute":        types.Int64Type,
+	"duration_mins": types.Int64Type,
+	"manual_only":   types.BoolType,
+	"disable_until": types.StringType,
+}
+
+type ServerlessCompactionModel struct {
+	MaximumCompactionConcurrency types.Int64  `tfsdk:"maximum_compaction_concurrency"`
+	Version                      types.String `tfsdk:"version"`
+}
+
+var serverlessCompactionAttrTypes = map[string]attr.Type{
+	"maximum_compaction_concurrency": types.Int64Type,
+	"version":                        types.StringType,
+}
+
+type ExtensionsModel struct {
+	ServerlessCompaction types.Object `tfsdk:"serverless_compaction"`
+}
+
+var extensionsAttrTypes = map[string]attr.Type{
+	"serverless_compaction": types.ObjectType{
+		AttrTypes: serverlessCompactionAttrTypes,
+	},
+}
+
+type ClusterEndpointModel struct {
+	Host         types.String `tfsdk:"host"`
+	Port         types.Int64  `tfsdk:"port"`
+	Database     types.String `tfsdk:"database"`
+	InternalHost types.String `tfsdk:"internal_host"`
+	InternalPort types.Int64  `tfsdk:"internal_port"`
+	Options      types.String `tfsdk:"options"`
+}
+
+var clusterEndpointAttrTypes = map[string]attr.Type{
+	"host":          types.StringType,
+	"port":          types.Int64Type,
+	"database":      types.StringType,
+	"internal_host": types.StringType,
+	"internal_port": types.Int64Type,
+	"options":       types.StringType,
+}
+
 type ClusterModel struct {
-	ID        types.String `tfsdk:"id"`
-	EncodedID types.String `tfsdk:"encoded_id"`
-	Tier      types.String `tfsdk:"tier"`
-	Region    types.String `tfsdk:"region"`
-	Name      types.String `tfsdk:"name"`
-	Version   types.String `tfsdk:"version"`
-	BYOC      types.Object `tfsdk:"byoc"`
-	Spec      types.Object `tfsdk:"spec"`
+	ID                 types.String `tfsdk:"id"`
+	EncodedID          types.String `tfsdk:"encoded_id"`
+	Tier               types.String `tfsdk:"tier"`
+	Region             types.String `tfsdk:"region"`
+	Name               types.String `tfsdk:"name"`
+	Version            types.String `tfsdk:"version"`
+	BYOC               types.Object `tfsdk:"byoc"`
+	BYOK               types.Object `tfsdk:"byok"`
+	ServiceAccountName types.String `tfsdk:"service_account_name"`
+	Endpoint           types.Object `tfsdk:"endpoint"`
+	Spec               types.Object `tfsdk:"spec"`
+	RestoreFrom        types.Object `tfsdk:"restore_from"`
+	MaintenanceWindow  types.Object `tfsdk:"maintenance_window"`
+	Extensions         types.Object `tfsdk:"extensions"`
+	PowerState         types.String `tfsdk:"power_state"`
+	RestartTrigger     types.Map    `tfsdk:"restart_trigger"`
 }
 
 type NodeGroupModel struct {
@@ -148,6 +282,118 @@ type NodeGroupModel struct {
 	Replica types.Int64  `tfsdk:"replica"`
 }
 
+// int64RangeValidator checks a value against the range the API spec documents for it.
+type int64RangeValidator struct {
+	min, max int64
+}
+
+func (v int64RangeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if value := req.ConfigValue.ValueInt64(); value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Value out of range",
+			fmt.Sprintf("Expected a value between %d and %d, got: %d", v.min, v.max, value),
+		)
+	}
+}
+
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be a timestamp in RFC 3339 format"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("Expected a timestamp in RFC 3339 format, e.g. 2025-01-02T15:04:05Z, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// powerStateValidator checks the power_state attribute of a cluster.
+type powerStateValidator struct{}
+
+var powerStates = []string{PowerStateRunning, PowerStateStopped}
+
+func (v powerStateValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %v", powerStates)
+}
+
+func (v powerStateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v powerStateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, state := range powerStates {
+		if state == req.ConfigValue.ValueString() {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid power state",
+		fmt.Sprintf("Expected one of %v, got: %q", powerStates, req.ConfigValue.ValueString()),
+	)
+}
+
+// computeCachePerformanceTierValidator checks a performance tier against the ones the API spec
+// knows of. Whether the cluster can use it is checked against its capabilities in ModifyPlan.
+type computeCachePerformanceTierValidator struct{}
+
+var computeCachePerformanceTiers = []apigen_mgmtv2.ComputeCachePerformanceTier{
+	apigen_mgmtv2.ComputeCachePerformanceTierStandard,
+	apigen_mgmtv2.ComputeCachePerformanceTierPerformance,
+	apigen_mgmtv2.ComputeCachePerformanceTierUltra,
+}
+
+func (v computeCachePerformanceTierValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %v", computeCachePerformanceTiers)
+}
+
+func (v computeCachePerformanceTierValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v computeCachePerformanceTierValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, tier := range computeCachePerformanceTiers {
+		if string(tier) == req.ConfigValue.ValueString() {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid performance tier",
+		fmt.Sprintf("Expected one of %v, got: %q", computeCachePerformanceTiers, req.ConfigValue.ValueString()),
+	)
+}
+
 func (r *ClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cluster"
 }
@@ -194,8 +440,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"tier": schema.StringAttribute{
-				MarkdownDescription: "The tier of your RisingWave cluster. Supported values: `Standard`, `Invited`, `BYOC`. " +
-					"Defaults to `Standard` for SaaS clusters and `BYOC` when a `byoc` block is present. " +
+				MarkdownDescription: "The tier of your RisingWave cluster. Supported values: `Standard`, `Invited`, `BYOC`, `BYOK`. " +
+					"Defaults to `Standard` for SaaS clusters, `BYOC` when a `byoc` block is present, `BYOK` when a `byok` " +
+					"block is present, and the tier of the source cluster when `restore_from` is present. " +
 					"Cannot be changed after creation.",
 				Optional: true,
 				Computed: true,
@@ -238,6 +485,228 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"byok": schema.SingleNestedAttribute{
+				MarkdownDescription: "The BYOK (Bring Your Own Kubernetes) configuration of the cluster. " +
+					"These fields are only used in BYOK clusters. The platform does not report them, so they are not read back.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"env": schema.StringAttribute{
+						MarkdownDescription: "The BYOK environment the cluster runs in, e.g. the `name` of a " +
+							"`risingwavecloud_byok_environment` resource.",
+						Required: true,
+					},
+					"iam_role_arn": schema.StringAttribute{
+						MarkdownDescription: "The ARN of the AWS IAM role the pods of the cluster assume through IRSA. " +
+							"Its trust policy must allow `service_account_name`.",
+						Optional: true,
+					},
+					"metastore": schema.SingleNestedAttribute{
+						MarkdownDescription: "The PostgreSQL database the cluster keeps its metadata in.",
+						Required:            true,
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								MarkdownDescription: "The host of the PostgreSQL server.",
+								Required:            true,
+							},
+							"port": schema.Int64Attribute{
+								MarkdownDescription: "The port of the PostgreSQL server.",
+								Required:            true,
+								Validators: []validator.Int64{
+									int64RangeValidator{min: 1, max: 65535},
+								},
+							},
+							"database": schema.StringAttribute{
+								MarkdownDescription: "The database to keep the metadata in.",
+								Required:            true,
+							},
+							"username": schema.StringAttribute{
+								MarkdownDescription: "The user to connect as.",
+								Required:            true,
+							},
+							"password_wo": schema.StringAttribute{
+								MarkdownDescription: "The password of the user, as a " +
+									"[write-only argument](https://developer.hashicorp.com/terraform/language/manage-sensitive-data/write-only): " +
+									"Terraform sends it to the provider but stores it in neither the plan nor the state. Requires " +
+									"Terraform 1.11 or later.",
+								Required:  true,
+								Sensitive: true,
+								WriteOnly: true,
+							},
+							"password_wo_version": schema.Int64Attribute{
+								MarkdownDescription: "The version of `password_wo`. Terraform cannot detect a change in a value " +
+									"it does not store, so increment this whenever `password_wo` changes to have the new " +
+									"password applied.",
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			"service_account_name": schema.StringAttribute{
+				MarkdownDescription: "The Kubernetes service account the pods of the cluster run as. It is only set for " +
+					"BYOK clusters, where the trust policy of the IAM role in `byok.iam_role_arn` must allow it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.SingleNestedAttribute{
+				MarkdownDescription: "The endpoint to connect to the cluster with a Postgres client. It is null until the " +
+					"cluster is deployed, e.g. for a BYOK cluster waiting for its IAM role. To get a connection URI " +
+					"for a user, use the `risingwavecloud_cluster_endpoint` data source.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The host to connect to.",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port to connect to.",
+						Computed:            true,
+					},
+					"database": schema.StringAttribute{
+						MarkdownDescription: "The database to connect to by default.",
+						Computed:            true,
+					},
+					"internal_host": schema.StringAttribute{
+						MarkdownDescription: "The host to connect to from inside the network of the cluster, e.g. from a BYOC environment.",
+						Computed:            true,
+					},
+					"internal_port": schema.Int64Attribute{
+						MarkdownDescription: "The port to connect to from inside the network of the cluster.",
+						Computed:            true,
+					},
+					"options": schema.StringAttribute{
+						MarkdownDescription: "The value of the `options` connection parameter to connect with, if any.",
+						Computed:            true,
+					},
+				},
+			},
+			"restore_from": schema.SingleNestedAttribute{
+				MarkdownDescription: "The backup snapshot to create the cluster from. The cluster is restored from the " +
+					"snapshot into the region of the source cluster, then its `version` and `spec` are applied like " +
+					"on any other cluster. It is only used when the cluster is created: pointing it to another " +
+					"snapshot replaces the cluster, while removing it leaves the cluster as it is. Adding it to an " +
+					"existing cluster, e.g. an imported one, does not restore anything and does not replace the cluster: " +
+					"to restore an existing cluster from a snapshot, use `risingwavecloud_cluster_in_place_restore`.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"cluster_id": schema.StringAttribute{
+						MarkdownDescription: "The NsID (namespace id) of the cluster the snapshot was taken of.",
+						Required:            true,
+					},
+					"snapshot_id": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the snapshot, e.g. the `snapshot_id` of a `risingwavecloud_cluster_backup` resource.",
+						Required:            true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfRestoreFromChanged,
+						"Restoring from another snapshot replaces the cluster, removing the attribute does not.",
+						"Restoring from another snapshot replaces the cluster, removing the attribute does not.",
+					),
+				},
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "The weekly time window in which the platform may run maintenance on the cluster, " +
+					"e.g. automatic upgrades. The time is in UTC. The window is only managed once configured: " +
+					"removing the attribute leaves the window on the platform as it is.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"day": schema.Int64Attribute{
+						MarkdownDescription: "The day of the week the window starts, from `0` (Sunday) to `6` (Saturday).",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64RangeValidator{min: 0, max: 6},
+						},
+					},
+					"hour": schema.Int64Attribute{
+						MarkdownDescription: "The hour the window starts, from `0` to `23`.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64RangeValidator{min: 0, max: 23},
+						},
+					},
+					"minute": schema.Int64Attribute{
+						MarkdownDescription: "The minute the window starts, from `0` to `59`.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64RangeValidator{min: 0, max: 59},
+						},
+					},
+					"duration_mins": schema.Int64Attribute{
+						MarkdownDescription: "The length of the window in minutes, at most a week.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64RangeValidator{min: 1, max: 7 * 24 * 60},
+						},
+					},
+					"manual_only": schema.BoolAttribute{
+						MarkdownDescription: "Only run maintenance that is triggered manually, never automatically. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"disable_until": schema.StringAttribute{
+						MarkdownDescription: "Pause maintenance until the given time, in RFC 3339 format, e.g. `2025-01-02T15:04:05Z`.",
+						Optional:            true,
+						Validators: []validator.String{
+							rfc3339Validator{},
+						},
+					},
+				},
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "Whether the cluster is `running` or `stopped`. A stopped cluster keeps its data but " +
+					"runs no nodes. Changing it stops or starts the cluster, and the apply waits for the cluster to " +
+					"get there. Defaults to the state the cluster is in, i.e. `running` for a new cluster.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					powerStateValidator{},
+				},
+			},
+			"restart_trigger": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that restart the cluster when they change, like the `triggers` of a " +
+					"`terraform_data` resource. All nodes of the cluster are restarted one after another, and the apply " +
+					"waits for the cluster to be healthy again. Setting it on creation or removing it does not restart " +
+					"the cluster, neither does changing it while the cluster is stopped or is to be stopped.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"extensions": schema.SingleNestedAttribute{
+				MarkdownDescription: "The extensions of the cluster, which run parts of the workload outside of the cluster's own nodes.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"serverless_compaction": schema.SingleNestedAttribute{
+						MarkdownDescription: "Run compaction on serverless workers instead of the cluster's compactor nodes. " +
+							"The extension is enabled when the attribute is present and disabled when it is removed.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"maximum_compaction_concurrency": schema.Int64Attribute{
+								MarkdownDescription: "The maximum number of compaction tasks run at the same time.",
+								Required:            true,
+							},
+							"version": schema.StringAttribute{
+								MarkdownDescription: "The version of the serverless compaction workers. " +
+									"The platform picks one if this field is not present.",
+								Optional: true,
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+				},
+			},
 			"spec": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"compute": schema.SingleNestedAttribute{
@@ -292,6 +761,38 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"compute_cache": schema.SingleNestedAttribute{
+						MarkdownDescription: "The local disk cache of the compute nodes. Changing it restarts the compute nodes like a rescale. " +
+							"Removing the attribute leaves the cache as it is.",
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"size_gb": schema.Int64Attribute{
+								MarkdownDescription: fmt.Sprintf("The size of the cache in GB. Defaults to `%d` when the cluster is created. ", DefaultComputeFileCacheSizeGB) +
+									"The allowed range and step depend on the cluster and are checked while planning, a new cluster is only checked against the maximum of its tier.",
+								Optional: true,
+								Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+							},
+							"performance_tier": schema.StringAttribute{
+								MarkdownDescription: "The performance tier of the disks backing the cache: `standard`, `performance` or `ultra`. " +
+									"The platform's default is used if it is not set, and not every tier is available to every cluster.",
+								Optional: true,
+								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.String{
+									computeCachePerformanceTierValidator{},
+								},
+							},
+						},
+					},
 				},
 				Required:            true,
 				MarkdownDescription: "The resource specification of the cluster",
@@ -300,6 +801,23 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// clusterPowerState reports a cluster that is being stopped as stopped already, and one that is
+// starting as running, so that an interrupted apply does not stop or start it twice.
+func clusterPowerState(status apigen_mgmtv2.TenantStatus) string {
+	if status == apigen_mgmtv2.Stopped || status == apigen_mgmtv2.Stopping {
+		return PowerStateStopped
+	}
+	return PowerStateRunning
+}
+
+// requiresReplaceIfRestoreFromChanged replaces the cluster only when it is to be restored from a
+// different snapshot than the one it was restored from. Dropping the attribute, or adding it to a
+// cluster that was not restored (e.g. an imported one), changes nothing on the platform, so it
+// must not destroy a cluster that has been running since.
+func requiresReplaceIfRestoreFromChanged(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+}
+
 func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -320,8 +838,10 @@ func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
-func (r *ClusterResource) nodeGroupModelToComponentResource(
-	ctx context.Context, diags *diag.Diagnostics, nodeGroup *NodeGroupModel, region string, tier apigen_mgmtv2.TierId, component string,
+// nodeGroupModelToComponentResource picks the component type matching the requested CPU and
+// memory among the ones available to the tier in the region.
+func nodeGroupModelToComponentResource(
+	ctx context.Context, client cloudsdk.CloudClientInterface, diags *diag.Diagnostics, nodeGroup *NodeGroupModel, region string, tier apigen_mgmtv2.TierId, component string,
 ) *apigen_mgmtv2.ComponentResource {
 
 	var (
@@ -330,7 +850,7 @@ func (r *ClusterResource) nodeGroupModelToComponentResource(
 		reqReplica = nodeGroup.Replica.ValueInt64()
 	)
 
-	availableTypes, err := r.client.GetAvailableComponentTypes(ctx, region, apigen_mgmtv1.TierId(tier), component)
+	availableTypes, err := client.GetAvailableComponentTypes(ctx, region, apigen_mgmtv1.TierId(tier), component)
 	if err != nil {
 		diags.AddError(
 			"Failed to get available component types",
@@ -392,6 +912,8 @@ func clusterToDataModel(cluster *apigen_mgmtv2.Tenant, byocCluster *apigen_mgmtv
 	data.EncodedID = types.StringValue(rwcloud.BuildEncodedClusterID(cluster.NsId, cluster.TenantName))
 	data.Region = types.StringValue(cluster.Region)
 	data.Tier = types.StringValue(string(cluster.Tier))
+	data.PowerState = types.StringValue(clusterPowerState(cluster.Status))
+	data.ServiceAccountName = types.StringPointerValue(cluster.ServiceAccountName)
 
 	if cluster.Tier == apigen_mgmtv2.TierIdBYOC {
 		if cluster.ClusterName == "" {
@@ -429,9 +951,26 @@ func clusterToDataModel(cluster *apigen_mgmtv2.Tenant, byocCluster *apigen_mgmtv
 		metastoreTypeValue = types.StringValue(string(cluster.Resources.MetaStore.Type))
 	}
 
+	// the tenant only carries the size of the compute cache, the performance tier is filled in
+	// by readComputeCache.
+	performanceTierValue := types.StringNull()
+	if !data.Spec.IsNull() && !data.Spec.IsUnknown() {
+		var currentSpec ClusterSpecModel
+		if diags := data.Spec.As(context.Background(), &currentSpec, basetypes.ObjectAsOptions{}); !diags.HasError() && !currentSpec.ComputeCache.IsNull() && !currentSpec.ComputeCache.IsUnknown() {
+			var currentCache ComputeCacheModel
+			if diags := currentSpec.ComputeCache.As(context.Background(), &currentCache, basetypes.ObjectAsOptions{}); !diags.HasError() && !currentCache.PerformanceTier.IsUnknown() {
+				performanceTierValue = currentCache.PerformanceTier
+			}
+		}
+	}
+
 	specObj := map[string]attr.Value{
 		"risingwave_config": risingwaveConfigValue,
 		"metastore_type":    metastoreTypeValue,
+		"compute_cache": types.ObjectValueMust(computeCacheAttrTypes, map[string]attr.Value{
+			"size_gb":          types.Int64Value(int64(cluster.Resources.ComputeCache.SizeGb)),
+			"performance_tier": performanceTierValue,
+		}),
 	}
 
 	componentToObject := func(attrTypes map[string]attr.Type, comp *apigen_mgmtv2.ComponentResource) attr.Value {
@@ -474,6 +1013,7 @@ func (r *ClusterResource) dataModelToCluster(ctx context.Context, data *ClusterM
 	var (
 		spec ClusterSpecModel
 		byoc BYOCModel
+		byok BYOKModel
 	)
 
 	tflog.Trace(ctx, "parsing spec")
@@ -495,6 +1035,10 @@ func (r *ClusterResource) dataModelToCluster(ctx context.Context, data *ClusterM
 		diags.Append(data.BYOC.As(ctx, &byoc, objectAsOptions)...)
 		cluster.ClusterName = byoc.Env.ValueString()
 	}
+	if !data.BYOK.IsNull() && !data.BYOK.IsUnknown() {
+		diags.Append(data.BYOK.As(ctx, &byok, objectAsOptions)...)
+		cluster.ClusterName = byok.Env.ValueString()
+	}
 	cluster.TenantName = data.Name.ValueString()
 	cluster.ImageTag = data.Version.ValueString()
 	cluster.Tier = apigen_mgmtv2.TierId(data.Tier.ValueString())
@@ -512,7 +1056,7 @@ func (r *ClusterResource) dataModelToCluster(ctx context.Context, data *ClusterM
 		diags.Append(specObj.As(ctx, &compSpec, objectAsOptions)...)
 		var nodeGroup NodeGroupModel
 		diags.Append(compSpec.DefaultNodeGroup.As(ctx, &nodeGroup, objectAsOptions)...)
-		return r.nodeGroupModelToComponentResource(ctx, &diags, &nodeGroup, cluster.Region, cluster.Tier, component)
+		return nodeGroupModelToComponentResource(ctx, r.client, &diags, &nodeGroup, cluster.Region, cluster.Tier, component)
 	}
 
 	cluster.Resources = apigen_mgmtv2.TenantResource{
@@ -527,6 +1071,9 @@ func (r *ClusterResource) dataModelToCluster(ctx context.Context, data *ClusterM
 			SizeGb: DefaultComputeFileCacheSizeGB,
 		},
 	}
+	if computeCache := computeCacheFromModel(ctx, spec.ComputeCache, &diags); computeCache != nil {
+		cluster.Resources.ComputeCache.SizeGb = computeCache.SizeGb
+	}
 
 	if diags.HasError() {
 		return diags
@@ -544,90 +1091,40 @@ func (r *ClusterResource) dataModelToCluster(ctx context.Context, data *ClusterM
 	return diags
 }
 
-func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data ClusterModel
-
-	resp.Diagnostics.Append(r.dataHelper.Get(ctx, &req.Plan, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
+// byokConfigFromModel builds the BYOK configuration of the cluster. The password is write-only,
+// so it is read from the configuration by the caller.
+func byokConfigFromModel(ctx context.Context, obj types.Object, password string, diags *diag.Diagnostics) *apigen_mgmtv2.PostBYOKTenantConfigRequestBody {
 	var (
-		region = data.Region.ValueString()
+		byok      BYOKModel
+		metastore BYOKMetastoreModel
 	)
-
-	var cluster apigen_mgmtv2.Tenant
-
-	if len(region) == 0 {
-		resp.Diagnostics.AddError(
-			"Invalid region",
-			"Region is required",
-		)
-		return
+	diags.Append(obj.As(ctx, &byok, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
 	}
-
-	isBYOC := !data.BYOC.IsNull() && !data.BYOC.IsUnknown()
-
-	if data.Tier.IsNull() || data.Tier.IsUnknown() {
-		if isBYOC {
-			data.Tier = types.StringValue(string(apigen_mgmtv2.TierIdBYOC))
-		} else {
-			data.Tier = types.StringValue(string(apigen_mgmtv2.TierIdStandard))
-		}
-	} else {
-		tier := apigen_mgmtv2.TierId(data.Tier.ValueString())
-		if isBYOC && tier != apigen_mgmtv2.TierIdBYOC {
-			resp.Diagnostics.AddError(
-				"Invalid tier for BYOC cluster",
-				fmt.Sprintf("BYOC clusters must use the BYOC tier, got: %s", tier),
-			)
-			return
-		}
-		if !isBYOC && tier != apigen_mgmtv2.TierIdStandard && tier != apigen_mgmtv2.TierIdInvited {
-			resp.Diagnostics.AddError(
-				"Invalid tier for SaaS cluster",
-				fmt.Sprintf("SaaS clusters must use either Standard or Invited tier, got: %s", tier),
-			)
-			return
-		}
+	diags.Append(byok.Metastore.As(ctx, &metastore, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
 	}
 
-	resp.Diagnostics.Append(r.dataModelToCluster(ctx, &data, &cluster)...)
-	if resp.Diagnostics.HasError() {
-		return
+	req := &apigen_mgmtv2.PostBYOKTenantConfigRequestBody{
+		Metastore: apigen_mgmtv2.BYOKTenantMetastoreConfig{
+			Host:     metastore.Host.ValueString(),
+			Port:     int(metastore.Port.ValueInt64()),
+			Database: metastore.Database.ValueString(),
+			Username: metastore.Username.ValueString(),
+			Password: password,
+		},
 	}
-
-	c, err := r.client.GetClusterByRegionAndName(ctx, cluster.Region, cluster.TenantName)
-	if err != nil {
-		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
-			// no previous cluster found, continue the creation
-		} else {
-			// abort on unknown errors
-			resp.Diagnostics.AddError(
-				"Failed to get cluster",
-				err.Error(),
-			)
-			return
-		}
-	} else {
-		// a healthy cluster already exists
-		if c.Status != apigen_mgmtv2.Failed {
-			resp.Diagnostics.AddError(
-				"Cluster already exists",
-				fmt.Sprintf("Cluster with the name %s already exists in the region %s", c.TenantName, region),
-			)
-			return
-		}
-		// delete the failed cluster
-		if err := r.client.DeleteClusterByNsIDAwait(ctx, c.NsId); err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to delete failed cluster before creation",
-				err.Error(),
-			)
-			return
+	if !byok.IamRoleArn.IsNull() {
+		req.Aws = &apigen_mgmtv2.BYOKTenantAWSConfig{
+			IamRoleArn: byok.IamRoleArn.ValueString(),
 		}
 	}
+	return req
+}
 
+func clusterToTenantRequest(cluster *apigen_mgmtv2.Tenant) apigen_mgmtv2.TenantRequestRequestBody {
 	var tenantReq = apigen_mgmtv2.TenantRequestRequestBody{}
 	if cluster.ClusterName != "" {
 		tenantReq.ClusterName = &cluster.ClusterName
@@ -660,9 +1157,791 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 			Type: cluster.Resources.MetaStore.Type,
 		}
 	}
+	return tenantReq
+}
 
-	createdCluster, err := r.client.CreateClusterAwait(ctx, region, tenantReq)
-	if err != nil {
+// maintenanceWindowFromModel returns nil when the maintenance window is not configured.
+func maintenanceWindowFromModel(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *apigen_mgmtv2.MaintenanceWindow {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var model MaintenanceWindowModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	window := &apigen_mgmtv2.MaintenanceWindow{
+		MaintenanceWindowTime: apigen_mgmtv2.MaintenanceWindowTime{
+			Day:          int(model.Day.ValueInt64()),
+			Hour:         int(model.Hour.ValueInt64()),
+			Minute:       int(model.Minute.ValueInt64()),
+			DurationMins: int(model.DurationMins.ValueInt64()),
+		},
+		ManualOnly: model.ManualOnly.ValueBoolPointer(),
+	}
+	if !model.DisableUntil.IsNull() && !model.DisableUntil.IsUnknown() {
+		disableUntil, err := time.Parse(time.RFC3339, model.DisableUntil.ValueString())
+		if err != nil {
+			diags.AddError("maintenance_window.disable_until is invalid", err.Error())
+			return nil
+		}
+		window.DisableUntil = &disableUntil
+	}
+	return window
+}
+
+// maintenanceWindowToObject keeps disable_until as configured when it is the same instant as the
+// one the platform returns, which may be in another time zone.
+func maintenanceWindowToObject(ctx context.Context, window *apigen_mgmtv2.MaintenanceWindow, prior types.Object) types.Object {
+	disableUntil := types.StringNull()
+	if window.DisableUntil != nil {
+		disableUntil = types.StringValue(window.DisableUntil.Format(time.RFC3339))
+
+		var priorModel MaintenanceWindowModel
+		if !prior.IsNull() && !prior.IsUnknown() && !prior.As(ctx, &priorModel, basetypes.ObjectAsOptions{}).HasError() {
+			if t, err := time.Parse(time.RFC3339, priorModel.DisableUntil.ValueString()); err == nil && t.Equal(*window.DisableUntil) {
+				disableUntil = priorModel.DisableUntil
+			}
+		}
+	}
+
+	manualOnly := false
+	if window.ManualOnly != nil {
+		manualOnly = *window.ManualOnly
+	}
+
+	return types.ObjectValueMust(maintenanceWindowAttrTypes, map[string]attr.Value{
+		"day":           types.Int64Value(int64(window.MaintenanceWindowTime.Day)),
+		"hour":          types.Int64Value(int64(window.MaintenanceWindowTime.Hour)),
+		"minute":        types.Int64Value(int64(window.MaintenanceWindowTime.Minute)),
+		"duration_mins": types.Int64Value(int64(window.MaintenanceWindowTime.DurationMins)),
+		"manual_only":   types.BoolValue(manualOnly),
+		"disable_until": disableUntil,
+	})
+}
+
+// readMaintenanceWindow refreshes the maintenance window in data. It is left alone unless it is
+// configured, so that a cluster that does not manage it never shows a diff for it.
+func (r *ClusterResource) readMaintenanceWindow(ctx context.Context, nsID uuid.UUID, data *ClusterModel, diags *diag.Diagnostics) {
+	if data.MaintenanceWindow.IsNull() || data.MaintenanceWindow.IsUnknown() {
+		return
+	}
+	window, err := r.client.GetClusterMaintenanceWindow(ctx, nsID)
+	if err != nil {
+		diags.AddError("Unable to read cluster maintenance window", err.Error())
+		return
+	}
+	data.MaintenanceWindow = maintenanceWindowToObject(ctx, window, data.MaintenanceWindow)
+}
+
+// readEndpoint refreshes the endpoint in data. A cluster that is not deployed yet has no
+// endpoint, which leaves it null.
+func (r *ClusterResource) readEndpoint(ctx context.Context, nsID uuid.UUID, data *ClusterModel, diags *diag.Diagnostics) {
+	data.Endpoint = types.ObjectNull(clusterEndpointAttrTypes)
+	endpoint, err := r.client.GetClusterEndpoint(ctx, nsID)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			return
+		}
+		diags.AddError("Unable to read cluster endpoint", err.Error())
+		return
+	}
+	value, d := types.ObjectValueFrom(ctx, clusterEndpointAttrTypes, clusterEndpointToDataModel(endpoint))
+	diags.Append(d...)
+	data.Endpoint = value
+}
+
+func clusterEndpointToDataModel(endpoint *apigen_mgmtv2.Endpoint) ClusterEndpointModel {
+	return ClusterEndpointModel{
+		Host:         types.StringValue(endpoint.Host),
+		Port:         types.Int64Value(int64(endpoint.Port)),
+		Database:     types.StringValue(endpoint.Database),
+		InternalHost: types.StringValue(endpoint.InternalHost),
+		InternalPort: types.Int64Value(int64(endpoint.InternalPort)),
+		Options:      types.StringValue(endpoint.Options),
+	}
+}
+
+// computeCacheFromModel returns nil when the compute cache is not configured. An unknown size
+// only happens before the cluster exists, where it falls back to the default size.
+func computeCacheFromModel(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *apigen_mgmtv2.PostTenantComputeCacheRequestBody {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var model ComputeCacheModel
+	diags.Append(obj.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	req := &apigen_mgmtv2.PostTenantComputeCacheRequestBody{
+		SizeGb: DefaultComputeFileCacheSizeGB,
+	}
+	if !model.SizeGB.IsNull() && !model.SizeGB.IsUnknown() {
+		req.SizeGb = int(model.SizeGB.ValueInt64())
+	}
+	if !model.PerformanceTier.IsNull() && !model.PerformanceTier.IsUnknown() {
+		req.PerformanceTier = ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTier(model.PerformanceTier.ValueString()))
+	}
+	return req
+}
+
+// specComputeCacheFromModel is computeCacheFromModel for the whole spec.
+func specComputeCacheFromModel(ctx context.Context, specObj types.Object, diags *diag.Diagnostics) *apigen_mgmtv2.PostTenantComputeCacheRequestBody {
+	if specObj.IsNull() || specObj.IsUnknown() {
+		return nil
+	}
+	var spec ClusterSpecModel
+	diags.Append(specObj.As(ctx, &spec, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return computeCacheFromModel(ctx, spec.ComputeCache, diags)
+}
+
+// computeCacheChanged reports whether the compute cache needs to be updated. An unset performance
+// tier keeps the current one.
+func computeCacheChanged(current apigen_mgmtv2.TenantComputeCacheConfig, desired *apigen_mgmtv2.PostTenantComputeCacheRequestBody) bool {
+	if current.SizeGb != desired.SizeGb {
+		return true
+	}
+	return desired.PerformanceTier != nil && (current.PerformanceTier == nil || *current.PerformanceTier != *desired.PerformanceTier)
+}
+
+// validateComputeCache checks the planned compute cache against the capabilities of the cluster.
+func validateComputeCache(capabilities *apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody, desired *apigen_mgmtv2.PostTenantComputeCacheRequestBody, diags *diag.Diagnostics) {
+	sizePath := path.Root("spec").AtName("compute_cache").AtName("size_gb")
+	if desired.SizeGb < capabilities.MinSizeGb || desired.SizeGb > capabilities.MaxSizeGb {
+		diags.AddAttributeError(
+			sizePath,
+			"Invalid compute cache size",
+			fmt.Sprintf("The compute cache size must be between %d and %d GB, got: %d", capabilities.MinSizeGb, capabilities.MaxSizeGb, desired.SizeGb),
+		)
+	} else if capabilities.SizeStepGb > 0 && (desired.SizeGb-capabilities.MinSizeGb)%capabilities.SizeStepGb != 0 {
+		diags.AddAttributeError(
+			sizePath,
+			"Invalid compute cache size",
+			fmt.Sprintf("The compute cache size must be %d GB plus a multiple of %d GB, got: %d", capabilities.MinSizeGb, capabilities.SizeStepGb, desired.SizeGb),
+		)
+	}
+
+	if desired.PerformanceTier == nil {
+		return
+	}
+	var available []string
+	for _, tier := range capabilities.AvailablePerformanceTiers {
+		if tier.Id == *desired.PerformanceTier {
+			return
+		}
+		available = append(available, string(tier.Id))
+	}
+	diags.AddAttributeError(
+		path.Root("spec").AtName("compute_cache").AtName("performance_tier"),
+		"Performance tier not available",
+		fmt.Sprintf("The performance tier %q is not available to the cluster, available tiers are: %v", *desired.PerformanceTier, available),
+	)
+}
+
+// validateNewComputeCache checks the compute cache of a cluster yet to be created. The capabilities
+// of a cluster can only be read once it exists, so only the maximum of its tier is checked.
+func validateNewComputeCache(tier *apigen_mgmtv1.Tier, desired *apigen_mgmtv2.PostTenantComputeCacheRequestBody, diags *diag.Diagnostics) {
+	sizePath := path.Root("spec").AtName("compute_cache").AtName("size_gb")
+	if desired.SizeGb < 1 {
+		diags.AddAttributeError(
+			sizePath,
+			"Invalid compute cache size",
+			fmt.Sprintf("The compute cache size must be at least 1 GB, got: %d", desired.SizeGb),
+		)
+	} else if tier.MaximumComputeNodeFileCacheSizeGiB > 0 && desired.SizeGb > tier.MaximumComputeNodeFileCacheSizeGiB {
+		diags.AddAttributeError(
+			sizePath,
+			"Invalid compute cache size",
+			fmt.Sprintf("The compute cache size must be at most %d GB in the %s tier, got: %d", tier.MaximumComputeNodeFileCacheSizeGiB, *tier.Id, desired.SizeGb),
+		)
+	}
+}
+
+// ModifyPlan checks the compute cache against what the cluster supports, so that an invalid size
+// is reported before anything is applied. A new cluster is checked against its tier, an existing
+// one against its own capabilities whenever its compute cache changes.
+func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned types.Object
+	computeCachePath := path.Root("spec").AtName("compute_cache")
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, computeCachePath, &planned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		r.modifyCreatePlan(ctx, req.Plan, planned, &resp.Diagnostics)
+		return
+	}
+
+	var current types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, computeCachePath, &current)...)
+	if resp.Diagnostics.HasError() || planned.Equal(current) {
+		return
+	}
+	desired := computeCacheFromModel(ctx, planned, &resp.Diagnostics)
+	if desired == nil {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nsID, err := uuid.Parse(id.ValueString())
+	if err != nil {
+		return
+	}
+
+	capabilities, err := r.client.GetComputeCacheCapabilities(ctx, nsID)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read compute cache capabilities", err.Error())
+		return
+	}
+	validateComputeCache(capabilities, desired, &resp.Diagnostics)
+}
+
+// modifyCreatePlan checks the compute cache of a new cluster against the tier it is going to be
+// created in. The tier of a restored cluster is the one of its source, which is left to Create.
+func (r *ClusterResource) modifyCreatePlan(ctx context.Context, plan tfsdk.Plan, planned types.Object, diags *diag.Diagnostics) {
+	desired := computeCacheFromModel(ctx, planned, diags)
+	if desired == nil {
+		return
+	}
+
+	var data ClusterModel
+	diags.Append(plan.Get(ctx, &data)...)
+	if diags.HasError() || data.Region.IsUnknown() || ((data.Tier.IsNull() || data.Tier.IsUnknown()) && !data.RestoreFrom.IsNull()) {
+		return
+	}
+	tierID := defaultClusterTier(!data.BYOC.IsNull(), !data.BYOK.IsNull())
+	if !data.Tier.IsNull() && !data.Tier.IsUnknown() {
+		tierID = apigen_mgmtv2.TierId(data.Tier.ValueString())
+	}
+
+	tiers, err := r.client.GetTiers(ctx, data.Region.ValueString())
+	if err != nil {
+		diags.AddError("Unable to read tiers", err.Error())
+		return
+	}
+	for _, tier := range tiers {
+		if tier.Id != nil && *tier.Id == apigen_mgmtv1.TierId(tierID) {
+			validateNewComputeCache(&tier, desired, diags)
+			return
+		}
+	}
+}
+
+// readComputeCache refreshes the compute cache in the spec of data.
+func (r *ClusterResource) readComputeCache(ctx context.Context, nsID uuid.UUID, data *ClusterModel, diags *diag.Diagnostics) {
+	computeCache, err := r.client.GetComputeCache(ctx, nsID)
+	if err != nil {
+		diags.AddError("Unable to read compute cache", err.Error())
+		return
+	}
+	performanceTier := types.StringNull()
+	if computeCache.Current.PerformanceTier != nil {
+		performanceTier = types.StringValue(string(*computeCache.Current.PerformanceTier))
+	}
+
+	attrs := data.Spec.Attributes()
+	attrs["compute_cache"] = types.ObjectValueMust(computeCacheAttrTypes, map[string]attr.Value{
+		"size_gb":          types.Int64Value(int64(computeCache.Current.SizeGb)),
+		"performance_tier": performanceTier,
+	})
+	data.Spec = types.ObjectValueMust(clusterSpecAttrTypes, attrs)
+}
+
+// reconcileComputeCache applies the desired compute cache to a running cluster.
+func (r *ClusterResource) reconcileComputeCache(ctx context.Context, nsID uuid.UUID, desired *apigen_mgmtv2.PostTenantComputeCacheRequestBody, diags *diag.Diagnostics) {
+	computeCache, err := r.client.GetComputeCache(ctx, nsID)
+	if err != nil {
+		diags.AddError("Unable to read compute cache", err.Error())
+		return
+	}
+	if !computeCacheChanged(computeCache.Current, desired) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("updating compute cache to %d GB, cluster: %s", desired.SizeGb, nsID))
+	if err := r.client.UpdateComputeCacheAwait(ctx, nsID, *desired); err != nil {
+		if errors.Is(err, wait.ErrWaitTimeout) {
+			diags.AddError(
+				"Timeout while waiting",
+				fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
+			)
+			return
+		}
+		diags.AddError(
+			"Unable to update compute cache",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "compute cache updated")
+}
+
+// setClusterPowerState stops or starts the cluster.
+func (r *ClusterResource) setClusterPowerState(ctx context.Context, nsID uuid.UUID, powerState string, diags *diag.Diagnostics) {
+	tflog.Info(ctx, fmt.Sprintf("changing the power state of cluster %s to %s", nsID, powerState))
+	var err error
+	if powerState == PowerStateStopped {
+		err = r.client.StopClusterAwait(ctx, nsID)
+	} else {
+		err = r.client.StartClusterAwait(ctx, nsID)
+	}
+	if err != nil {
+		if errors.Is(err, wait.ErrWaitTimeout) {
+			diags.AddError(
+				"Timeout while waiting",
+				fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
+			)
+			return
+		}
+		diags.AddError(
+			fmt.Sprintf("Unable to change the power state of the cluster to %s", powerState),
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("cluster power state changed to %s", powerState))
+}
+
+// serverlessCompactionFromModel returns nil when the serverless compaction extension is not
+// configured, i.e. it is to be disabled.
+func serverlessCompactionFromModel(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var extensions ExtensionsModel
+	diags.Append(obj.As(ctx, &extensions, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || extensions.ServerlessCompaction.IsNull() || extensions.ServerlessCompaction.IsUnknown() {
+		return nil
+	}
+	var model ServerlessCompactionModel
+	diags.Append(extensions.ServerlessCompaction.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	req := &apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{
+		MaximumCompactionConcurrency: int(model.MaximumCompactionConcurrency.ValueInt64()),
+	}
+	if !model.Version.IsNull() && !model.Version.IsUnknown() {
+		req.Version = model.Version.ValueStringPointer()
+	}
+	return req
+}
+
+// serverlessCompactionEnabled reports whether the serverless compaction extension of the cluster
+// is enabled.
+func serverlessCompactionEnabled(cluster *apigen_mgmtv2.Tenant) bool {
+	return cluster.Extensions != nil && cluster.Extensions.ServerlessCompaction != nil && cluster.Extensions.ServerlessCompaction.Enabled
+}
+
+// serverlessCompactionChanged reports whether the extension needs to be updated. An unset version
+// keeps the one the platform picked.
+func serverlessCompactionChanged(current *apigen_mgmtv2.GetTenantExtensionCompactionResponseBody, desired *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) bool {
+	if current.MaximumCompactionConcurrency == nil || *current.MaximumCompactionConcurrency != desired.MaximumCompactionConcurrency {
+		return true
+	}
+	return desired.Version != nil && (current.Version == nil || *current.Version != *desired.Version)
+}
+
+// readExtensions refreshes the extensions in data. An extension enabled elsewhere, in the
+// RisingWave Cloud console for instance, shows up as a diff to disable it.
+func (r *ClusterResource) readExtensions(ctx context.Context, cluster *apigen_mgmtv2.Tenant, data *ClusterModel, diags *diag.Diagnostics) {
+	serverlessCompaction := types.ObjectNull(serverlessCompactionAttrTypes)
+	if serverlessCompactionEnabled(cluster) {
+		compaction, err := r.client.GetServerlessCompaction(ctx, cluster.NsId)
+		if err != nil {
+			diags.AddError("Unable to read serverless compaction extension", err.Error())
+			return
+		}
+		version := types.StringNull()
+		if compaction.Version != nil {
+			version = types.StringValue(*compaction.Version)
+		}
+		serverlessCompaction = types.ObjectValueMust(serverlessCompactionAttrTypes, map[string]attr.Value{
+			"maximum_compaction_concurrency": types.Int64Value(int64(cluster.Extensions.ServerlessCompaction.MaximumCompactionConcurrency)),
+			"version":                        version,
+		})
+	} else if data.Extensions.IsNull() || data.Extensions.IsUnknown() {
+		// keep an unset attribute unset rather than showing an empty object
+		data.Extensions = types.ObjectNull(extensionsAttrTypes)
+		return
+	}
+	data.Extensions = types.ObjectValueMust(extensionsAttrTypes, map[string]attr.Value{
+		"serverless_compaction": serverlessCompaction,
+	})
+}
+
+// reconcileServerlessCompaction enables, updates or disables the serverless compaction extension
+// of a running cluster, desired being nil when it is to be disabled.
+func (r *ClusterResource) reconcileServerlessCompaction(
+	ctx context.Context, nsID uuid.UUID, previous *apigen_mgmtv2.Tenant, desired *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest, diags *diag.Diagnostics,
+) {
+	enabled := serverlessCompactionEnabled(previous)
+	if desired == nil && !enabled {
+		return
+	}
+	if desired != nil && enabled {
+		current, err := r.client.GetServerlessCompaction(ctx, nsID)
+		if err != nil {
+			diags.AddError("Unable to read serverless compaction extension", err.Error())
+			return
+		}
+		if !serverlessCompactionChanged(current, desired) {
+			return
+		}
+	}
+
+	var err error
+	switch {
+	case desired == nil:
+		tflog.Info(ctx, fmt.Sprintf("disabling serverless compaction, cluster: %s", previous.TenantName))
+		err = r.client.DisableServerlessCompactionAwait(ctx, nsID)
+	case !enabled:
+		tflog.Info(ctx, fmt.Sprintf("enabling serverless compaction, cluster: %s", previous.TenantName))
+		err = r.client.EnableServerlessCompactionAwait(ctx, nsID, *desired)
+	default:
+		tflog.Info(ctx, fmt.Sprintf("updating serverless compaction, cluster: %s", previous.TenantName))
+		err = r.client.UpdateServerlessCompactionAwait(ctx, nsID, *desired)
+	}
+	if err != nil {
+		if errors.Is(err, wait.ErrWaitTimeout) {
+			diags.AddError(
+				"Timeout while waiting",
+				fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
+			)
+			return
+		}
+		diags.AddError(
+			"Unable to update serverless compaction extension",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "serverless compaction extension updated")
+}
+
+// getRestoreSource returns the cluster the snapshot in restore_from was taken of.
+func (r *ClusterResource) getRestoreSource(ctx context.Context, data *ClusterModel, diags *diag.Diagnostics) (*apigen_mgmtv2.Tenant, uuid.UUID) {
+	var restoreFrom RestoreFromModel
+	diags.Append(data.RestoreFrom.As(ctx, &restoreFrom, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, uuid.Nil
+	}
+
+	sourceNsID, err := uuid.Parse(restoreFrom.ClusterID.ValueString())
+	if err != nil {
+		diags.AddError("restore_from.cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", restoreFrom.ClusterID.String()))
+		return nil, uuid.Nil
+	}
+	snapshotID, err := uuid.Parse(restoreFrom.SnapshotID.ValueString())
+	if err != nil {
+		diags.AddError("restore_from.snapshot_id is invalid", fmt.Sprintf("Cannot parse snapshot ID %s", restoreFrom.SnapshotID.String()))
+		return nil, uuid.Nil
+	}
+
+	source, err := r.client.GetClusterByNsID(ctx, sourceNsID)
+	if err != nil {
+		diags.AddError("Unable to read the cluster to restore from", err.Error())
+		return nil, uuid.Nil
+	}
+	return source, snapshotID
+}
+
+// checkRestoreSource rejects what a restore cannot give: the restored cluster lands in the
+// region of the source cluster and keeps its tier, BYOC or BYOK environment and metastore. Checking them
+// up front fails the apply before a cluster is created that reconciling could not fix.
+func checkRestoreSource(source, cluster *apigen_mgmtv2.Tenant, diags *diag.Diagnostics) {
+	if source.Region != cluster.Region {
+		diags.AddError(
+			"Invalid region for restored cluster",
+			fmt.Sprintf("A cluster is restored into the region of the source cluster %s, expected: %s, got: %s", source.TenantName, source.Region, cluster.Region),
+		)
+	}
+	if source.Tier != cluster.Tier {
+		diags.AddError(
+			"Invalid tier for restored cluster",
+			fmt.Sprintf("A restored cluster keeps the tier of the source cluster %s, expected: %s, got: %s", source.TenantName, source.Tier, cluster.Tier),
+		)
+	}
+	if (source.Tier == apigen_mgmtv2.TierIdBYOC || source.Tier == apigen_mgmtv2.TierIdBYOK) && source.ClusterName != cluster.ClusterName {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s environment for restored cluster", source.Tier),
+			fmt.Sprintf("A restored cluster keeps the %s environment of the source cluster %s, expected: %s, got: %s", source.Tier, source.TenantName, source.ClusterName, cluster.ClusterName),
+		)
+	}
+	if !metaStoreEqual(source.Resources.MetaStore, cluster.Resources.MetaStore) {
+		diags.AddError(
+			"Invalid metastore for restored cluster",
+			fmt.Sprintf("A restored cluster keeps the metastore of the source cluster %s, expected: %v, got: %v", source.TenantName, source.Resources.MetaStore, cluster.Resources.MetaStore),
+		)
+	}
+}
+
+// restoreClusterAwait restores the snapshot into a new cluster and reconciles it with the
+// configuration, including what the creation request would have carried but the restore request
+// cannot: the maintenance window and the extensions. The restored cluster is recorded in the state
+// as soon as it exists, before any failure is returned or reported in diags.
+func (r *ClusterResource) restoreClusterAwait(
+	ctx context.Context, sourceNsID, snapshotID uuid.UUID, cluster *apigen_mgmtv2.Tenant, tenantReq *apigen_mgmtv2.TenantRequestRequestBody,
+	state *tfsdk.State, diags *diag.Diagnostics,
+) (*apigen_mgmtv2.Tenant, error) {
+	nsID, err := r.client.RestoreCluster(ctx, sourceNsID, snapshotID, cluster.TenantName)
+	if err != nil {
+		return nil, err
+	}
+
+	// record the id first so a failed wait or update taints the cluster instead of leaking it.
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), nsID.String())...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	restored, err := r.client.WaitClusterRunning(ctx, nsID)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("cluster restored from snapshot %s, UUID: %s", snapshotID, restored.NsId))
+
+	r.reconcileCluster(ctx, restored.NsId, restored, cluster, diags)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	var serverlessCompaction *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest
+	if tenantReq.Extensions != nil {
+		serverlessCompaction = tenantReq.Extensions.ServerlessCompaction
+	}
+	r.reconcileServerlessCompaction(ctx, restored.NsId, restored, serverlessCompaction, diags)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	if tenantReq.MaintenanceWindow != nil {
+		if err := r.client.UpdateClusterMaintenanceWindow(ctx, restored.NsId, *tenantReq.MaintenanceWindow); err != nil {
+			diags.AddError("Unable to update cluster maintenance window", err.Error())
+			return nil, nil
+		}
+	}
+
+	return r.client.GetClusterByNsID(ctx, restored.NsId)
+}
+
+// validateClusterTier checks the configured tier against the environment block of the cluster.
+// defaultClusterTier is the tier a cluster is created in when it does not set one.
+func defaultClusterTier(isBYOC, isBYOK bool) apigen_mgmtv2.TierId {
+	switch {
+	case isBYOC:
+		return apigen_mgmtv2.TierIdBYOC
+	case isBYOK:
+		return apigen_mgmtv2.TierIdBYOK
+	default:
+		return apigen_mgmtv2.TierIdStandard
+	}
+}
+
+func validateClusterTier(tier apigen_mgmtv2.TierId, isBYOC, isBYOK bool, diags *diag.Diagnostics) {
+	switch {
+	case isBYOC && tier != apigen_mgmtv2.TierIdBYOC:
+		diags.AddError(
+			"Invalid tier for BYOC cluster",
+			fmt.Sprintf("BYOC clusters must use the BYOC tier, got: %s", tier),
+		)
+	case isBYOK && tier != apigen_mgmtv2.TierIdBYOK:
+		diags.AddError(
+			"Invalid tier for BYOK cluster",
+			fmt.Sprintf("BYOK clusters must use the BYOK tier, got: %s", tier),
+		)
+	case !isBYOC && !isBYOK && tier != apigen_mgmtv2.TierIdStandard && tier != apigen_mgmtv2.TierIdInvited:
+		diags.AddError(
+			"Invalid tier for SaaS cluster",
+			fmt.Sprintf("SaaS clusters must use either Standard or Invited tier, got: %s", tier),
+		)
+	}
+}
+
+// createBYOKCluster creates a BYOK cluster and hands it its BYOK configuration. The platform only
+// deploys the cluster once it has the configuration, and the pods of the cluster can only start
+// once the IAM role they assume trusts the service account of the cluster. That role is often
+// created in the same apply from service_account_name, i.e. after this returns, so unlike other
+// clusters this does not wait for the cluster to be running. The cluster is recorded in the state
+// as soon as it exists.
+func (r *ClusterResource) createBYOKCluster(
+	ctx context.Context, region string, tenantReq apigen_mgmtv2.TenantRequestRequestBody, byokConfig *apigen_mgmtv2.PostBYOKTenantConfigRequestBody,
+	state *tfsdk.State, diags *diag.Diagnostics,
+) (*apigen_mgmtv2.Tenant, error) {
+	created, err := r.client.CreateClusterAwaitingConfig(ctx, region, tenantReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// record the id first so a failed configuration taints the cluster instead of leaking it.
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), created.NsId.String())...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	if _, err := r.client.UpdateClusterBYOKConfig(ctx, created.NsId, *byokConfig); err != nil {
+		return nil, err
+	}
+
+	return r.client.GetClusterByNsID(ctx, created.NsId)
+}
+
+func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterModel
+
+	resp.Diagnostics.Append(r.dataHelper.Get(ctx, &req.Plan, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		region = data.Region.ValueString()
+	)
+
+	var cluster apigen_mgmtv2.Tenant
+
+	if len(region) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid region",
+			"Region is required",
+		)
+		return
+	}
+
+	isBYOC := !data.BYOC.IsNull() && !data.BYOC.IsUnknown()
+	isBYOK := !data.BYOK.IsNull() && !data.BYOK.IsUnknown()
+	stopAfterCreation := data.PowerState.ValueString() == PowerStateStopped
+
+	if isBYOC && isBYOK {
+		resp.Diagnostics.AddError(
+			"Conflicting cluster environments",
+			"A cluster runs either in a BYOC or in a BYOK environment, set only one of `byoc` and `byok`",
+		)
+		return
+	}
+	if isBYOK && stopAfterCreation {
+		resp.Diagnostics.AddError(
+			"Invalid power state for BYOK cluster",
+			"A BYOK cluster cannot be stopped before it is deployed, create it running and stop it afterwards",
+		)
+		return
+	}
+
+	var (
+		restoreSource     *apigen_mgmtv2.Tenant
+		restoreSnapshotID uuid.UUID
+	)
+	if !data.RestoreFrom.IsNull() && !data.RestoreFrom.IsUnknown() {
+		restoreSource, restoreSnapshotID = r.getRestoreSource(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.Tier.IsNull() || data.Tier.IsUnknown() {
+		if restoreSource != nil {
+			data.Tier = types.StringValue(string(restoreSource.Tier))
+		} else {
+			data.Tier = types.StringValue(string(defaultClusterTier(isBYOC, isBYOK)))
+		}
+	} else {
+		validateClusterTier(apigen_mgmtv2.TierId(data.Tier.ValueString()), isBYOC, isBYOK, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.dataModelToCluster(ctx, &data, &cluster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenantReq := clusterToTenantRequest(&cluster)
+	tenantReq.MaintenanceWindow = maintenanceWindowFromModel(ctx, data.MaintenanceWindow, &resp.Diagnostics)
+	if serverlessCompaction := serverlessCompactionFromModel(ctx, data.Extensions, &resp.Diagnostics); serverlessCompaction != nil {
+		tenantReq.Extensions = &apigen_mgmtv2.TenantExtensionsRequest{
+			ServerlessCompaction: serverlessCompaction,
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if restoreSource != nil {
+		checkRestoreSource(restoreSource, &cluster, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var byokConfig *apigen_mgmtv2.PostBYOKTenantConfigRequestBody
+	if isBYOK && restoreSource == nil {
+		password := readWriteOnlyValue(ctx, req.Config, path.Root("byok").AtName("metastore").AtName("password_wo"), &resp.Diagnostics)
+		byokConfig = byokConfigFromModel(ctx, data.BYOK, password, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	c, err := r.client.GetClusterByRegionAndName(ctx, cluster.Region, cluster.TenantName)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			// no previous cluster found, continue the creation
+		} else {
+			// abort on unknown errors
+			resp.Diagnostics.AddError(
+				"Failed to get cluster",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		// a healthy cluster already exists
+		if c.Status != apigen_mgmtv2.Failed {
+			resp.Diagnostics.AddError(
+				"Cluster already exists",
+				fmt.Sprintf("Cluster with the name %s already exists in the region %s", c.TenantName, region),
+			)
+			return
+		}
+		// delete the failed cluster
+		if err := r.client.DeleteClusterByNsIDAwait(ctx, c.NsId); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to delete failed cluster before creation",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	var createdCluster *apigen_mgmtv2.Tenant
+	if restoreSource != nil {
+		createdCluster, err = r.restoreClusterAwait(ctx, restoreSource.NsId, restoreSnapshotID, &cluster, &tenantReq, &resp.State, &resp.Diagnostics)
+	} else if byokConfig != nil {
+		createdCluster, err = r.createBYOKCluster(ctx, region, tenantReq, byokConfig, &resp.State, &resp.Diagnostics)
+	} else {
+		createdCluster, err = r.client.CreateClusterAwait(ctx, region, tenantReq)
+	}
+	if err != nil {
 		if errors.Is(err, wait.ErrWaitTimeout) {
 			resp.Diagnostics.AddError(
 				"Timeout while waiting",
@@ -676,6 +1955,42 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		)
 		return
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the create request only carries the size of the compute cache, and a restored cluster
+	// starts with the compute cache of the snapshot's cluster.
+	if computeCache := specComputeCacheFromModel(ctx, data.Spec, &resp.Diagnostics); computeCache != nil && (restoreSource != nil || computeCache.PerformanceTier != nil) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), createdCluster.NsId.String())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.reconcileComputeCache(ctx, createdCluster.NsId, computeCache, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// the platform always creates a running cluster.
+	if stopAfterCreation {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), createdCluster.NsId.String())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		r.setClusterPowerState(ctx, createdCluster.NsId, PowerStateStopped, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createdCluster, err = r.client.GetClusterByNsID(ctx, createdCluster.NsId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read cluster",
+				err.Error(),
+			)
+			return
+		}
+	}
 
 	var byocCluster *apigen_mgmtv2.ManagedCluster
 	byocCluster, err = r.client.GetBYOCCluster(ctx, region, cluster.ClusterName)
@@ -691,6 +2006,10 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(clusterToDataModel(createdCluster, byocCluster, &data)...)
+	r.readComputeCache(ctx, createdCluster.NsId, &data, &resp.Diagnostics)
+	r.readMaintenanceWindow(ctx, createdCluster.NsId, &data, &resp.Diagnostics)
+	r.readEndpoint(ctx, createdCluster.NsId, &data, &resp.Diagnostics)
+	r.readExtensions(ctx, createdCluster, &data, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -740,10 +2059,11 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	if cluster.Tier != apigen_mgmtv2.TierIdStandard && cluster.Tier != apigen_mgmtv2.TierIdInvited && cluster.Tier != apigen_mgmtv2.TierIdBYOC {
+	if cluster.Tier != apigen_mgmtv2.TierIdStandard && cluster.Tier != apigen_mgmtv2.TierIdInvited &&
+		cluster.Tier != apigen_mgmtv2.TierIdBYOC && cluster.Tier != apigen_mgmtv2.TierIdBYOK {
 		resp.Diagnostics.AddError(
 			"Invalid tier",
-			"Supported tiers are: Standard, Invited, BYOC",
+			"Supported tiers are: Standard, Invited, BYOC, BYOK",
 		)
 		return
 	}
@@ -763,6 +2083,10 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(clusterToDataModel(cluster, byocCluster, &data)...)
+	r.readComputeCache(ctx, nsID, &data, &resp.Diagnostics)
+	r.readMaintenanceWindow(ctx, nsID, &data, &resp.Diagnostics)
+	r.readEndpoint(ctx, nsID, &data, &resp.Diagnostics)
+	r.readExtensions(ctx, cluster, &data, &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -850,8 +2174,8 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 	}
 
-	// only check clusterName for BYOC
-	if previous.Tier == apigen_mgmtv2.TierIdBYOC {
+	// only check clusterName for BYOC and BYOK, where it is the environment of the cluster
+	if previous.Tier == apigen_mgmtv2.TierIdBYOC || previous.Tier == apigen_mgmtv2.TierIdBYOK {
 		if previous.ClusterName != updated.ClusterName {
 			resp.Diagnostics.AddError(
 				"Cannot update immutable field",
@@ -883,10 +2207,104 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// update version
-	if previous.ImageTag != updated.ImageTag {
-		tflog.Info(ctx, fmt.Sprintf("updating version from %s to %s, cluster: %s", previous.ImageTag, updated.ImageTag, previous.TenantName))
-		if err := r.client.UpdateClusterImageByNsIDAwait(ctx, nsID, updated.ImageTag); err != nil {
+	// the compute cache is left as it is on the platform once removed from the configuration.
+	computeCache := specComputeCacheFromModel(ctx, data.Spec, &resp.Diagnostics)
+	serverlessCompaction := serverlessCompactionFromModel(ctx, data.Extensions, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the BYOK configuration is not read back: apply it when it changes, which includes a new
+	// password_wo_version.
+	var byokConfig *apigen_mgmtv2.PostBYOKTenantConfigRequestBody
+	if !data.BYOK.IsNull() && !data.BYOK.Equal(state.BYOK) {
+		password := readWriteOnlyValue(ctx, req.Config, path.Root("byok").AtName("metastore").AtName("password_wo"), &resp.Diagnostics)
+		byokConfig = byokConfigFromModel(ctx, data.BYOK, password, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	mutated := byokConfig != nil ||
+		clusterChanged(previous, &updated) ||
+		computeCachePending(specComputeCacheFromModel(ctx, state.Spec, &resp.Diagnostics), computeCache) ||
+		serverlessCompactionPending(serverlessCompactionEnabled(previous), serverlessCompactionFromModel(ctx, state.Extensions, &resp.Diagnostics), serverlessCompaction)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// none of the changes above can be applied to a stopped cluster, so it is started for them and
+	// stopped again afterwards unless it is to be running. Other changes leave it stopped.
+	powerState := clusterPowerState(previous.Status)
+	if !data.PowerState.IsNull() && !data.PowerState.IsUnknown() {
+		powerState = data.PowerState.ValueString()
+	}
+	stopped := clusterPowerState(previous.Status) == PowerStateStopped
+	started := stopped && (mutated || powerState == PowerStateRunning)
+	if started {
+		r.setClusterPowerState(ctx, nsID, PowerStateRunning, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if mutated {
+		r.reconcileCluster(ctx, nsID, previous, &updated, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if computeCache != nil {
+			r.reconcileComputeCache(ctx, nsID, computeCache, &resp.Diagnostics)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.reconcileServerlessCompaction(ctx, nsID, previous, serverlessCompaction, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if byokConfig != nil {
+			tflog.Info(ctx, fmt.Sprintf("updating BYOK configuration, cluster: %s", previous.TenantName))
+			if err := r.client.UpdateClusterBYOKConfigAwait(ctx, nsID, *byokConfig); err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to update BYOK configuration",
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	// update maintenance window, it is left as it is on the platform once removed from the
+	// configuration.
+	if !data.MaintenanceWindow.IsNull() && !data.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		maintenanceWindow := maintenanceWindowFromModel(ctx, data.MaintenanceWindow, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("updating maintenance window, cluster: %s", previous.TenantName))
+		if err := r.client.UpdateClusterMaintenanceWindow(ctx, nsID, *maintenanceWindow); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to update cluster maintenance window",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// restarting a cluster that is to be stopped is pointless, and one started by this update runs
+	// on fresh nodes already.
+	if powerState == PowerStateStopped {
+		if !stopped || started {
+			r.setClusterPowerState(ctx, nsID, PowerStateStopped, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	} else if !started && !data.RestartTrigger.IsNull() && !data.RestartTrigger.Equal(state.RestartTrigger) {
+		tflog.Info(ctx, fmt.Sprintf("restart trigger changed, restarting cluster: %s", previous.TenantName))
+		if err := r.client.RestartClusterAwait(ctx, nsID); err != nil {
 			if errors.Is(err, wait.ErrWaitTimeout) {
 				resp.Diagnostics.AddError(
 					"Timeout while waiting",
@@ -895,6 +2313,108 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 				return
 			}
 			resp.Diagnostics.AddError(
+				"Unable to restart cluster",
+				err.Error(),
+			)
+			return
+		}
+		tflog.Info(ctx, "cluster restarted")
+	}
+
+	// Get the latest cluster state and save it to state
+	now, err := r.client.GetClusterByNsID(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read cluster",
+			err.Error(),
+		)
+		return
+	}
+	var byocCluster *apigen_mgmtv2.ManagedCluster
+	byocCluster, err = r.client.GetBYOCCluster(ctx, now.Region, now.ClusterName)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrBYOCClusterNotFound) {
+			byocCluster = nil
+		} else {
+			resp.Diagnostics.AddError(
+				"Unable to read BYOC cluster",
+				err.Error(),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(clusterToDataModel(now, byocCluster, &data)...)
+	r.readComputeCache(ctx, nsID, &data, &resp.Diagnostics)
+	r.readMaintenanceWindow(ctx, nsID, &data, &resp.Diagnostics)
+	r.readEndpoint(ctx, nsID, &data, &resp.Diagnostics)
+	r.readExtensions(ctx, now, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// componentsEqual reports whether the updated cluster has the same nodes as the previous one.
+func componentsEqual(previous, updated *apigen_mgmtv2.Tenant) bool {
+	return resourceEqual(previous.Resources.Components.Compute, updated.Resources.Components.Compute) &&
+		resourceEqual(previous.Resources.Components.Compactor, updated.Resources.Components.Compactor) &&
+		resourceEqual(previous.Resources.Components.Frontend, updated.Resources.Components.Frontend) &&
+		resourceEqual(previous.Resources.Components.Meta, updated.Resources.Components.Meta) &&
+		resourceEqual(previous.Resources.Components.Standalone, updated.Resources.Components.Standalone)
+}
+
+// clusterChanged reports whether reconcileCluster sends any request for the updated cluster.
+func clusterChanged(previous, updated *apigen_mgmtv2.Tenant) bool {
+	return (updated.ImageTag != "" && previous.ImageTag != updated.ImageTag) ||
+		previous.RwConfig != updated.RwConfig ||
+		!componentsEqual(previous, updated)
+}
+
+// computeCachePending reports whether the planned compute cache differs from the one in state,
+// i.e. whether reconcileComputeCache is going to update it.
+func computeCachePending(current, desired *apigen_mgmtv2.PostTenantComputeCacheRequestBody) bool {
+	if desired == nil {
+		return false
+	}
+	return current == nil || computeCacheChanged(apigen_mgmtv2.TenantComputeCacheConfig{
+		SizeGb:          current.SizeGb,
+		PerformanceTier: current.PerformanceTier,
+	}, desired)
+}
+
+// serverlessCompactionPending reports whether reconcileServerlessCompaction is going to enable,
+// update or disable the extension, current being the one in state.
+func serverlessCompactionPending(enabled bool, current, desired *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest) bool {
+	if desired == nil {
+		return enabled
+	}
+	if !enabled {
+		return true
+	}
+	return current == nil || serverlessCompactionChanged(&apigen_mgmtv2.GetTenantExtensionCompactionResponseBody{
+		MaximumCompactionConcurrency: &current.MaximumCompactionConcurrency,
+		Version:                      current.Version,
+	}, desired)
+}
+
+// reconcileCluster applies the version, the RisingWave configuration and the resources of the
+// updated cluster to a running one, in that order.
+func (r *ClusterResource) reconcileCluster(ctx context.Context, nsID uuid.UUID, previous, updated *apigen_mgmtv2.Tenant, diags *diag.Diagnostics) {
+	// update version, an unset version keeps the one the cluster runs (e.g. the version of the
+	// snapshot a cluster is restored from)
+	if updated.ImageTag != "" && previous.ImageTag != updated.ImageTag {
+		tflog.Info(ctx, fmt.Sprintf("updating version from %s to %s, cluster: %s", previous.ImageTag, updated.ImageTag, previous.TenantName))
+		if err := r.client.UpdateClusterImageByNsIDAwait(ctx, nsID, updated.ImageTag); err != nil {
+			if errors.Is(err, wait.ErrWaitTimeout) {
+				diags.AddError(
+					"Timeout while waiting",
+					fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
+				)
+				return
+			}
+			diags.AddError(
 				"Unable to update cluster version",
 				err.Error(),
 			)
@@ -908,13 +2428,13 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		tflog.Info(ctx, fmt.Sprintf("updating risingwave configuration, cluster: %s", previous.TenantName))
 		if err := r.client.UpdateRisingWaveConfigByNsIDAwait(ctx, nsID, updated.RwConfig); err != nil {
 			if errors.Is(err, wait.ErrWaitTimeout) {
-				resp.Diagnostics.AddError(
+				diags.AddError(
 					"Timeout while waiting",
 					fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
 				)
 				return
 			}
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to update cluster risingwave config",
 				err.Error(),
 			)
@@ -924,11 +2444,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// update cluster components
-	if !(resourceEqual(previous.Resources.Components.Compute, updated.Resources.Components.Compute) &&
-		resourceEqual(previous.Resources.Components.Compactor, updated.Resources.Components.Compactor) &&
-		resourceEqual(previous.Resources.Components.Frontend, updated.Resources.Components.Frontend) &&
-		resourceEqual(previous.Resources.Components.Meta, updated.Resources.Components.Meta) &&
-		resourceEqual(previous.Resources.Components.Standalone, updated.Resources.Components.Standalone)) {
+	if !componentsEqual(previous, updated) {
 
 		tflog.Info(ctx, fmt.Sprintf("updating resources, cluster: %s", previous.TenantName))
 		updateComponentReq := func(comp *apigen_mgmtv2.ComponentResource) *apigen_mgmtv2.ComponentResourceRequest {
@@ -948,13 +2464,13 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 			Standalone: updateComponentReq(updated.Resources.Components.Standalone),
 		}); err != nil {
 			if errors.Is(err, wait.ErrWaitTimeout) {
-				resp.Diagnostics.AddError(
+				diags.AddError(
 					"Timeout while waiting",
 					fmt.Sprintf("The cluster did not reach the desired state before the timeout: %s", err.Error()),
 				)
 				return
 			}
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to update cluster resources",
 				err.Error(),
 			)
@@ -962,36 +2478,6 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		tflog.Info(ctx, "cluster resources updated")
 	}
-
-	// Get the latest cluster state and save it to state
-	now, err := r.client.GetClusterByNsID(ctx, nsID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read cluster",
-			err.Error(),
-		)
-		return
-	}
-	var byocCluster *apigen_mgmtv2.ManagedCluster
-	byocCluster, err = r.client.GetBYOCCluster(ctx, now.Region, now.ClusterName)
-	if err != nil {
-		if errors.Is(err, cloudsdk.ErrBYOCClusterNotFound) {
-			byocCluster = nil
-		} else {
-			resp.Diagnostics.AddError(
-				"Unable to read BYOC cluster",
-				err.Error(),
-			)
-		}
-	}
-
-	resp.Diagnostics.Append(clusterToDataModel(now, byocCluster, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterBackupResource{}
+var _ resource.ResourceWithImportState = &ClusterBackupResource{}
+
+func NewClusterBackupResource() resource.Resource {
+	return &ClusterBackupResource{}
+}
+
+type ClusterBackupResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterBackupModel struct {
+	// [cluster ID].[snapshot ID]
+	ID           types.String `tfsdk:"id"`
+	ClusterID    types.String `tfsdk:"cluster_id"`
+	SnapshotID   types.String `tfsdk:"snapshot_id"`
+	RwSnapshotID types.Int64  `tfsdk:"rw_snapshot_id"`
+	RwVersion    types.String `tfsdk:"rw_version"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func (r *ClusterBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_backup"
+}
+
+func (r *ClusterBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "An on-demand backup snapshot of a RisingWave cluster.",
+		MarkdownDescription: clusterBackupMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [cluster ID].[snapshot ID]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster to take the snapshot of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the snapshot on the RisingWave Cloud platform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rw_snapshot_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the snapshot in the RisingWave meta service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rw_version": schema.StringAttribute{
+				MarkdownDescription: "The RisingWave version the cluster was running when the snapshot was taken.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the snapshot was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the snapshot reported by the platform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterBackupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	snapshotID, err := r.client.CreateBackupSnapshot(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create backup snapshot", err.Error())
+		return
+	}
+
+	// record the id first so a failed wait taints the snapshot instead of leaking it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s.%s", nsID.String(), snapshotID.String()))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), nsID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("snapshot_id"), snapshotID.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.WaitBackupSnapshotCompleted(ctx, nsID, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create backup snapshot", err.Error())
+		return
+	}
+
+	clusterBackupToDataModel(nsID, snapshot, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("backup snapshot created, ID: %s", snapshotID.String()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func clusterBackupToDataModel(clusterNsID uuid.UUID, snapshot *apigen_mgmtv2.BackupSnapshotItem, data *ClusterBackupModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", clusterNsID.String(), snapshot.Id.String()))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.SnapshotID = types.StringValue(snapshot.Id.String())
+	data.RwSnapshotID = types.Int64Value(int64(snapshot.RwSnapshotId))
+	data.RwVersion = types.StringValue(snapshot.RwVersion)
+	data.CreatedAt = types.StringValue(snapshot.CreatedAt.Format(time.RFC3339))
+	data.Status = types.StringValue(snapshot.Status)
+}
+
+// parseClusterBackupIdentifier splits `[cluster ID].[snapshot ID]`.
+func parseClusterBackupIdentifier(backupResourceID string, diags *diag.Diagnostics) (nsID, snapshotID uuid.UUID) {
+	arr := strings.Split(backupResourceID, ".")
+	if len(arr) != 2 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse backup ID: %s, expected format: [cluster ID].[snapshot ID]", backupResourceID))
+		return
+	}
+	var err error
+	nsID, err = uuid.Parse(arr[0])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract cluster ID from backup ID: %s", backupResourceID))
+		return
+	}
+	snapshotID, err = uuid.Parse(arr[1])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract snapshot ID from backup ID: %s", backupResourceID))
+		return
+	}
+	return
+}
+
+func (r *ClusterBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterBackupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, snapshotID := parseClusterBackupIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.GetBackupSnapshot(ctx, nsID, snapshotID)
+	if err != nil {
+		// the platform deletes snapshots on its own once they expire, and all of them with the
+		// cluster: report it as deleted so that the next apply takes a new one.
+		if errors.Is(err, cloudsdk.ErrBackupSnapshotNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("backup snapshot %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read backup snapshot", err.Error())
+		return
+	}
+
+	clusterBackupToDataModel(nsID, snapshot, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterBackupModel
+
+	// a snapshot is immutable: every configurable attribute requires a replacement, so there
+	// is nothing to send to the platform.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterBackupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, snapshotID := parseClusterBackupIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBackupSnapshotAwait(ctx, nsID, snapshotID); err != nil {
+		// the snapshots of a cluster are deleted together with it.
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("cluster %s not found, the backup snapshot is already deleted", nsID.String()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to delete backup snapshot", err.Error())
+		return
+	}
+}
+
+func (r *ClusterBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, snapshotID := parseClusterBackupIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetBackupSnapshot(ctx, nsID, snapshotID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import backup snapshot with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterBackupIdentifier(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+	snapshotID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name      string
+		id        string
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			id:   nsID.String() + "." + snapshotID.String(),
+		},
+		{
+			name:      "missing snapshot ID",
+			id:        nsID.String() + ".",
+			expectErr: true,
+		},
+		{
+			name:      "missing separator",
+			id:        nsID.String(),
+			expectErr: true,
+		},
+		{
+			name:      "invalid cluster ID",
+			id:        "not-a-uuid." + snapshotID.String(),
+			expectErr: true,
+		},
+		{
+			name:      "invalid snapshot ID",
+			id:        nsID.String() + ".not-a-uuid",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			gotNsID, gotSnapshotID := parseClusterBackupIdentifier(tt.id, &diags)
+
+			if tt.expectErr {
+				assert.True(t, diags.HasError())
+				return
+			}
+			assert.False(t, diags.HasError())
+			assert.Equal(t, nsID, gotNsID)
+			assert.Equal(t, snapshotID, gotSnapshotID)
+		})
+	}
+}
+
+func TestClusterBackupToDataModel(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+	snapshotID := uuid.Must(uuid.NewRandom())
+
+	var data ClusterBackupModel
+	clusterBackupToDataModel(nsID, &apigen_mgmtv2.BackupSnapshotItem{
+		Id:           snapshotID,
+		CreatedAt:    time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC),
+		RwSnapshotId: 42,
+		RwVersion:    "v2.3.0",
+		Status:       "COMPLETED",
+	}, &data)
+
+	assert.Equal(t, nsID.String()+"."+snapshotID.String(), data.ID.ValueString())
+	assert.Equal(t, nsID.String(), data.ClusterID.ValueString())
+	assert.Equal(t, snapshotID.String(), data.SnapshotID.ValueString())
+	assert.Equal(t, int64(42), data.RwSnapshotID.ValueInt64())
+	assert.Equal(t, "v2.3.0", data.RwVersion.ValueString())
+	assert.Equal(t, "2024-05-01T08:30:00Z", data.CreatedAt.ValueString())
+	assert.Equal(t, "COMPLETED", data.Status.ValueString())
+}
+
+func TestClusterBackupCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		waitErr error
+	}{
+		{
+			name: "completed",
+		},
+		{
+			name:    "wait failed",
+			waitErr: errors.New("snapshot failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx        = context.Background()
+				nsID       = uuid.Must(uuid.NewRandom())
+				snapshotID = uuid.Must(uuid.NewRandom())
+			)
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.
+				EXPECT().
+				CreateBackupSnapshot(ctx, nsID).
+				Return(snapshotID, nil)
+			var snapshot *apigen_mgmtv2.BackupSnapshotItem
+			if tt.waitErr == nil {
+				snapshot = &apigen_mgmtv2.BackupSnapshotItem{
+					Id:           snapshotID,
+					CreatedAt:    time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC),
+					RwSnapshotId: 42,
+					RwVersion:    "v2.3.0",
+					Status:       "COMPLETED",
+				}
+			}
+			client.
+				EXPECT().
+				WaitBackupSnapshotCompleted(ctx, nsID, snapshotID).
+				Return(snapshot, tt.waitErr)
+
+			r := &ClusterBackupResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"cluster_id":     tftypes.NewValue(tftypes.String, nsID.String()),
+						"snapshot_id":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"rw_snapshot_id": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+						"rw_version":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"created_at":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"status":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			// the id is in the state either way, so a failed snapshot is tainted rather than leaked.
+			var data ClusterBackupModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, nsID.String()+"."+snapshotID.String(), data.ID.ValueString())
+			assert.Equal(t, snapshotID.String(), data.SnapshotID.ValueString())
+
+			if tt.waitErr != nil {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, "Unable to create backup snapshot", resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			assert.Equal(t, "COMPLETED", data.Status.ValueString())
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterIcebergCompactionResource{}
+var _ resource.ResourceWithImportState = &ClusterIcebergCompactionResource{}
+
+func NewClusterIcebergCompactionResource() resource.Resource {
+	return &ClusterIcebergCompactionResource{}
+}
+
+// ClusterIcebergCompactionResource manages the iceberg compaction extension of a cluster. A
+// cluster has at most one, so the resource is identified by the cluster ID alone.
+type ClusterIcebergCompactionResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterIcebergCompactionModel struct {
+	// the cluster ID
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Compactor types.Object `tfsdk:"compactor"`
+	Config    types.String `tfsdk:"config"`
+	Status    types.String `tfsdk:"status"`
+}
+
+func (r *ClusterIcebergCompactionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_iceberg_compaction"
+}
+
+func (r *ClusterIcebergCompactionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The iceberg compaction extension of a RisingWave cluster, which runs dedicated compactor nodes for the Iceberg tables the cluster sinks to.",
+		MarkdownDescription: clusterIcebergCompactionMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource, which is the NsID of the cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compactor": schema.SingleNestedAttribute{
+				MarkdownDescription: "The resource specification of the iceberg compactor nodes. The available " +
+					"configurations are the compactor component types of the cluster's tier.",
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"cpu": schema.StringAttribute{
+						MarkdownDescription: "The CPU of the node",
+						Required:            true,
+					},
+					"memory": schema.StringAttribute{
+						MarkdownDescription: "The memory size in of the node",
+						Required:            true,
+					},
+					"replica": schema.Int64Attribute{
+						MarkdownDescription: "The number of nodes",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+					},
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The configuration of the iceberg compactor in TOML format. " +
+					"The platform's default is used if it is not set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the extension reported by the platform.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterIcebergCompactionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// icebergCompactorResource picks the compactor component type for the requested node group. The
+// extension runs on the same component types as the cluster's own compactor.
+func (r *ClusterIcebergCompactionResource) icebergCompactorResource(ctx context.Context, nsID uuid.UUID, data *ClusterIcebergCompactionModel, diags *diag.Diagnostics) *apigen_mgmtv2.ComponentResourceRequest {
+	cluster, err := r.client.GetClusterByNsID(ctx, nsID)
+	if err != nil {
+		diags.AddError("Unable to read cluster", err.Error())
+		return nil
+	}
+
+	var nodeGroup NodeGroupModel
+	diags.Append(data.Compactor.As(ctx, &nodeGroup, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	component := nodeGroupModelToComponentResource(ctx, r.client, diags, &nodeGroup, cluster.Region, cluster.Tier, cloudsdk.ComponentCompactor)
+	if component == nil {
+		return nil
+	}
+	return &apigen_mgmtv2.ComponentResourceRequest{
+		ComponentTypeId: component.ComponentTypeId,
+		Replica:         component.Replica,
+	}
+}
+
+// icebergCompactionConfig returns nil when the config is left to the platform.
+func icebergCompactionConfig(data *ClusterIcebergCompactionModel) *string {
+	if data.Config.IsNull() || data.Config.IsUnknown() {
+		return nil
+	}
+	return data.Config.ValueStringPointer()
+}
+
+func clusterIcebergCompactionToDataModel(nsID uuid.UUID, compaction *apigen_mgmtv2.IcebergCompaction, data *ClusterIcebergCompactionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(nsID.String())
+	data.ClusterID = types.StringValue(nsID.String())
+	data.Status = types.StringValue(compaction.Status)
+	if compaction.Config != nil {
+		data.Config = types.StringValue(*compaction.Config)
+	} else {
+		data.Config = types.StringValue("")
+	}
+
+	if compaction.Resources != nil {
+		compactor, d := types.ObjectValue(defaultNodeGroup, map[string]attr.Value{
+			"cpu":     types.StringValue(compaction.Resources.Cpu),
+			"memory":  types.StringValue(compaction.Resources.Memory),
+			"replica": types.Int64Value(int64(compaction.Resources.Replica)),
+		})
+		diags.Append(d...)
+		data.Compactor = compactor
+	}
+	return diags
+}
+
+func (r *ClusterIcebergCompactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterIcebergCompactionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	resources := r.icebergCompactorResource(ctx, nsID, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compaction, err := r.client.EnableIcebergCompactionAwait(ctx, nsID, apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody{
+		Config:    icebergCompactionConfig(&data),
+		Resources: resources,
+	}, func() {
+		// record the id once the request is accepted so a failed wait taints the extension
+		// instead of leaking it.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), nsID.String())...)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to enable iceberg compaction", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("iceberg compaction enabled in cluster %s", nsID.String()))
+
+	resp.Diagnostics.Append(clusterIcebergCompactionToDataModel(nsID, compaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterIcebergCompactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterIcebergCompactionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from iceberg compaction ID: %s", data.ID.ValueString()))
+		return
+	}
+
+	compaction, err := r.client.GetIcebergCompaction(ctx, nsID)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrIcebergCompactionNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("iceberg compaction of cluster %s not found, removing it from the state", nsID.String()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read iceberg compaction", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(clusterIcebergCompactionToDataModel(nsID, compaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterIcebergCompactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data  ClusterIcebergCompactionModel
+		state ClusterIcebergCompactionModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from iceberg compaction ID: %s", state.ID.ValueString()))
+		return
+	}
+
+	resources := r.icebergCompactorResource(ctx, nsID, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compaction, err := r.client.UpdateIcebergCompactionAwait(ctx, nsID, apigen_mgmtv2.PutTenantsNsIdExtensionsIcebergCompactionJSONRequestBody{
+		Config:    icebergCompactionConfig(&data),
+		Resources: resources,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update iceberg compaction", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(clusterIcebergCompactionToDataModel(nsID, compaction, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterIcebergCompactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterIcebergCompactionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from iceberg compaction ID: %s", data.ID.ValueString()))
+		return
+	}
+
+	if err := r.client.DisableIcebergCompactionAwait(ctx, nsID); err != nil {
+		// the extension goes away with the cluster.
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("cluster %s not found, the iceberg compaction is already disabled", nsID.String()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to disable iceberg compaction", err.Error())
+		return
+	}
+}
+
+func (r *ClusterIcebergCompactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID: %s", req.ID))
+		return
+	}
+
+	if _, err := r.client.GetIcebergCompaction(ctx, nsID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import iceberg compaction with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
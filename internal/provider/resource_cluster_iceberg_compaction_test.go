@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterIcebergCompactionCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cpu       string
+		memory    string
+		enableErr error
+		waitErr   error
+		expectErr string
+	}{
+		{
+			name:   "enabled",
+			cpu:    "2",
+			memory: "8 GB",
+		},
+		{
+			name:      "configuration not available",
+			cpu:       "4",
+			memory:    "16 GB",
+			expectErr: "Invalid configuration",
+		},
+		{
+			name:      "enable rejected",
+			cpu:       "2",
+			memory:    "8 GB",
+			enableErr: errors.New("expected status code 202 but got 400, message: cluster is busy"),
+			expectErr: "Unable to enable iceberg compaction",
+		},
+		{
+			name:      "wait failed",
+			cpu:       "2",
+			memory:    "8 GB",
+			waitErr:   errors.New("the platform failed to apply the iceberg compaction extension"),
+			expectErr: "Unable to enable iceberg compaction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx  = context.Background()
+				nsID = uuid.Must(uuid.NewRandom())
+			)
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.
+				EXPECT().
+				GetClusterByNsID(ctx, nsID).
+				Return(&apigen_mgmtv2.Tenant{
+					NsId:   nsID,
+					Region: "us-east-1",
+					Tier:   apigen_mgmtv2.TierIdBYOC,
+				}, nil)
+			client.
+				EXPECT().
+				GetAvailableComponentTypes(ctx, "us-east-1", apigen_mgmtv1.BYOC, cloudsdk.ComponentCompactor).
+				Return([]apigen_mgmtv1.AvailableComponentType{
+					{Id: "p-1c4g", Cpu: "1", Memory: "4 GB", Maximum: 3},
+					{Id: "p-2c8g", Cpu: "2", Memory: "8 GB", Maximum: 3},
+				}, nil)
+			if tt.expectErr == "" || tt.enableErr != nil || tt.waitErr != nil {
+				client.
+					EXPECT().
+					EnableIcebergCompactionAwait(ctx, nsID, apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody{
+						Resources: &apigen_mgmtv2.ComponentResourceRequest{
+							ComponentTypeId: "p-2c8g",
+							Replica:         2,
+						},
+					}, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ apigen_mgmtv2.PostTenantsNsIdExtensionsIcebergCompactionJSONRequestBody, accepted func()) (*apigen_mgmtv2.IcebergCompaction, error) {
+						if tt.enableErr != nil {
+							return nil, tt.enableErr
+						}
+						accepted()
+						if tt.waitErr != nil {
+							return nil, tt.waitErr
+						}
+						return &apigen_mgmtv2.IcebergCompaction{
+							Config: ptr.Ptr("[compactor]"),
+							Resources: &apigen_mgmtv2.ComponentResource{
+								ComponentTypeId: "p-2c8g",
+								Cpu:             "2",
+								Memory:          "8 GB",
+								Replica:         2,
+							},
+							Status: cloudsdk.IcebergCompactionStatusRunning,
+						}, nil
+					})
+			}
+
+			r := &ClusterIcebergCompactionResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			compactorType := objectType.(tftypes.Object).AttributeTypes["compactor"]
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+						"compactor": tftypes.NewValue(compactorType, map[string]tftypes.Value{
+							"cpu":     tftypes.NewValue(tftypes.String, tt.cpu),
+							"memory":  tftypes.NewValue(tftypes.String, tt.memory),
+							"replica": tftypes.NewValue(tftypes.Number, 2),
+						}),
+						"config": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"status": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			if tt.expectErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectErr, resp.Diagnostics.Errors()[0].Summary())
+				if tt.waitErr == nil {
+					assert.True(t, resp.State.Raw.IsNull())
+					return
+				}
+				// the extension was accepted, so the id is kept for terraform to taint it.
+				var id types.String
+				require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+				assert.Equal(t, nsID.String(), id.ValueString())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data ClusterIcebergCompactionModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, nsID.String(), data.ID.ValueString())
+			assert.Equal(t, "[compactor]", data.Config.ValueString())
+			assert.Equal(t, cloudsdk.IcebergCompactionStatusRunning, data.Status.ValueString())
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterInPlaceRestoreResource{}
+
+func NewClusterInPlaceRestoreResource() resource.Resource {
+	return &ClusterInPlaceRestoreResource{}
+}
+
+// ClusterInPlaceRestoreResource models a one-off operation rather than an object on the
+// platform: creating it restores the snapshot, and nothing happens when it is destroyed.
+type ClusterInPlaceRestoreResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterInPlaceRestoreModel struct {
+	// [cluster ID].[snapshot ID]
+	ID              types.String `tfsdk:"id"`
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	SnapshotID      types.String `tfsdk:"snapshot_id"`
+	ConfirmDataLoss types.Bool   `tfsdk:"confirm_data_loss"`
+	RestoredAt      types.String `tfsdk:"restored_at"`
+}
+
+// mustBeTrueValidator turns a bool attribute into an explicit acknowledgement: the attribute
+// is required, and false is rejected at plan time.
+type mustBeTrueValidator struct{}
+
+func (v mustBeTrueValidator) Description(ctx context.Context) string {
+	return "value must be true"
+}
+
+func (v mustBeTrueValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be `true`"
+}
+
+func (v mustBeTrueValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !req.ConfigValue.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Confirmation required",
+			"Restoring a snapshot in place discards every change made to the cluster since the snapshot was taken. Set the attribute to true to confirm.",
+		)
+	}
+}
+
+func (r *ClusterInPlaceRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_in_place_restore"
+}
+
+func (r *ClusterInPlaceRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Restores a backup snapshot into the RisingWave cluster it was taken of, replacing the cluster's data.",
+		MarkdownDescription: clusterInPlaceRestoreMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [cluster ID].[snapshot ID]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster to restore. The cluster must be running.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the backup snapshot to restore, which must be a snapshot of the same cluster. " +
+					"Changing it restores the new snapshot.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_data_loss": schema.BoolAttribute{
+				MarkdownDescription: "Must be `true`. The restore discards every change made to the cluster since the snapshot " +
+					"was taken, and cannot be undone.",
+				Required: true,
+				Validators: []validator.Bool{
+					mustBeTrueValidator{},
+				},
+			},
+			"restored_at": schema.StringAttribute{
+				MarkdownDescription: "The time the restore completed, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterInPlaceRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClusterInPlaceRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterInPlaceRestoreModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+	snapshotID, err := uuid.Parse(data.SnapshotID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("snapshot_id is invalid", fmt.Sprintf("Cannot parse snapshot ID %s", data.SnapshotID.String()))
+		return
+	}
+
+	if err := r.client.InPlaceRestoreClusterAwait(ctx, nsID, snapshotID); err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotRunning) {
+			resp.Diagnostics.AddError(
+				"Cluster is not running",
+				fmt.Sprintf("The snapshot is only restored into a running cluster, wait for the ongoing operation to finish and apply again: %s", err.Error()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to restore backup snapshot", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("backup snapshot %s restored into cluster %s", snapshotID.String(), nsID.String()))
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", nsID.String(), snapshotID.String()))
+	data.RestoredAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseClusterInPlaceRestoreIdentifier splits `[cluster ID].[snapshot ID]`.
+func parseClusterInPlaceRestoreIdentifier(id string, diags *diag.Diagnostics) (nsID, snapshotID uuid.UUID) {
+	arr := strings.Split(id, ".")
+	if len(arr) != 2 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse in-place restore ID: %s, expected format: [cluster ID].[snapshot ID]", id))
+		return
+	}
+	var err error
+	nsID, err = uuid.Parse(arr[0])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract cluster ID from in-place restore ID: %s", id))
+		return
+	}
+	snapshotID, err = uuid.Parse(arr[1])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract snapshot ID from in-place restore ID: %s", id))
+		return
+	}
+	return
+}
+
+func (r *ClusterInPlaceRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterInPlaceRestoreModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, _ := parseClusterInPlaceRestoreIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the restore is done once and for all: the snapshot expiring later does not undo it, only
+	// the cluster going away does.
+	if _, err := r.client.GetClusterByNsID(ctx, nsID); err != nil {
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("cluster %s not found, removing the in-place restore from the state", nsID.String()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read cluster", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterInPlaceRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ClusterInPlaceRestoreModel
+
+	// only confirm_data_loss can change without a replacement, and it is not sent anywhere.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterInPlaceRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// a restore cannot be undone: destroying the resource only removes it from the state.
+	tflog.Info(ctx, "removing the in-place restore from the state, the cluster is left untouched")
+}
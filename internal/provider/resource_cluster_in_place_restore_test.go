@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMustBeTrueValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.Bool
+		expectErr bool
+	}{
+		{
+			name:  "true",
+			value: types.BoolValue(true),
+		},
+		{
+			name:      "false",
+			value:     types.BoolValue(false),
+			expectErr: true,
+		},
+		{
+			name:  "unknown",
+			value: types.BoolUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.BoolResponse{}
+			mustBeTrueValidator{}.ValidateBool(context.Background(), validator.BoolRequest{
+				Path:        path.Root("confirm_data_loss"),
+				ConfigValue: tt.value,
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestClusterInPlaceRestoreCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		expectErr string
+	}{
+		{
+			name: "restored",
+		},
+		{
+			name:      "cluster is not running",
+			err:       errors.Wrap(cloudsdk.ErrClusterNotRunning, "current status: Updating"),
+			expectErr: "Cluster is not running",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx        = context.Background()
+				nsID       = uuid.Must(uuid.NewRandom())
+				snapshotID = uuid.Must(uuid.NewRandom())
+			)
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.
+				EXPECT().
+				InPlaceRestoreClusterAwait(ctx, nsID, snapshotID).
+				Return(tt.err)
+
+			r := &ClusterInPlaceRestoreResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"cluster_id":        tftypes.NewValue(tftypes.String, nsID.String()),
+						"snapshot_id":       tftypes.NewValue(tftypes.String, snapshotID.String()),
+						"confirm_data_loss": tftypes.NewValue(tftypes.Bool, true),
+						"restored_at":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			if tt.expectErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectErr, resp.Diagnostics.Errors()[0].Summary())
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data ClusterInPlaceRestoreModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, nsID.String()+"."+snapshotID.String(), data.ID.ValueString())
+			assert.NotEmpty(t, data.RestoredAt.ValueString())
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterServerlessBackfillResource{}
+var _ resource.ResourceWithImportState = &ClusterServerlessBackfillResource{}
+
+func NewClusterServerlessBackfillResource() resource.Resource {
+	return &ClusterServerlessBackfillResource{}
+}
+
+// ClusterServerlessBackfillResource manages the serverless backfill extension of a cluster. A
+// cluster has at most one, so the resource is identified by the cluster ID alone.
+type ClusterServerlessBackfillResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ClusterServerlessBackfillModel struct {
+	// the cluster ID
+	ID              types.String `tfsdk:"id"`
+	ClusterID       types.String `tfsdk:"cluster_id"`
+	ComponentTypeID types.String `tfsdk:"component_type_id"`
+	Replica         types.Int64  `tfsdk:"replica"`
+	Version         types.String `tfsdk:"version"`
+}
+
+func (r *ClusterServerlessBackfillResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_serverless_backfill"
+}
+
+func (r *ClusterServerlessBackfillResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The serverless backfill extension of a RisingWave cluster, which runs the backfill of new streaming jobs on dedicated compute nodes.",
+		MarkdownDescription: clusterServerlessBackfillMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource, which is the NsID of the cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"component_type_id": schema.StringAttribute{
+				MarkdownDescription: "The compute node component type ID (e.g. \"p-1c4g\") used by the backfill workers. Available component types depend on the cluster tier.",
+				Required:            true,
+			},
+			"replica": schema.Int64Attribute{
+				MarkdownDescription: "The number of backfill workers. At least 1; the maximum depends on the component type.",
+				Required:            true,
+				Validators: []validator.Int64{
+					positiveReplicaValidator{},
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the serverless backfill extension. The platform picks one if it is not set. " +
+					"The platform does not report the version back, so a version changed outside of Terraform is not detected.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *ClusterServerlessBackfillResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func serverlessBackfillRequestFromModel(data *ClusterServerlessBackfillModel) apigen_mgmtv2.TenantExtensionServerlessBackfillRequest {
+	req := apigen_mgmtv2.TenantExtensionServerlessBackfillRequest{
+		Resources: apigen_mgmtv2.ComponentResourceRequest{
+			ComponentTypeId: data.ComponentTypeID.ValueString(),
+			Replica:         int(data.Replica.ValueInt64()),
+		},
+	}
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		req.Version = data.Version.ValueStringPointer()
+	}
+	return req
+}
+
+// serverlessBackfillToDataModel leaves the version alone, the platform does not report it.
+func serverlessBackfillToDataModel(nsID uuid.UUID, backfill *apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody, data *ClusterServerlessBackfillModel) {
+	data.ID = types.StringValue(nsID.String())
+	data.ClusterID = types.StringValue(nsID.String())
+	if backfill.Resources != nil {
+		data.ComponentTypeID = types.StringValue(backfill.Resources.ComponentTypeId)
+		data.Replica = types.Int64Value(int64(backfill.Resources.Replica))
+	}
+}
+
+func (r *ClusterServerlessBackfillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterServerlessBackfillModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	if err := r.client.EnableServerlessBackfillAwait(ctx, nsID, serverlessBackfillRequestFromModel(&data), func() {
+		// record the id once the request is accepted so a failed wait taints the extension
+		// instead of leaking it.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), nsID.String())...)
+	}); err != nil {
+		resp.Diagnostics.AddError("Unable to enable serverless backfill", err.Error())
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("serverless backfill enabled in cluster %s", nsID.String()))
+
+	backfill, err := r.client.GetServerlessBackfill(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read serverless backfill", err.Error())
+		return
+	}
+	serverlessBackfillToDataModel(nsID, backfill, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterServerlessBackfillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterServerlessBackfillModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from serverless backfill ID: %s", data.ID.ValueString()))
+		return
+	}
+
+	backfill, err := r.client.GetServerlessBackfill(ctx, nsID)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrServerlessBackfillNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("serverless backfill of cluster %s not found, removing it from the state", nsID.String()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read serverless backfill", err.Error())
+		return
+	}
+
+	serverlessBackfillToDataModel(nsID, backfill, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterServerlessBackfillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data  ClusterServerlessBackfillModel
+		state ClusterServerlessBackfillModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from serverless backfill ID: %s", state.ID.ValueString()))
+		return
+	}
+
+	if err := r.client.UpdateServerlessBackfillAwait(ctx, nsID, serverlessBackfillRequestFromModel(&data)); err != nil {
+		resp.Diagnostics.AddError("Unable to update serverless backfill", err.Error())
+		return
+	}
+
+	backfill, err := r.client.GetServerlessBackfill(ctx, nsID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read serverless backfill", err.Error())
+		return
+	}
+	serverlessBackfillToDataModel(nsID, backfill, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterServerlessBackfillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterServerlessBackfillModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID from serverless backfill ID: %s", data.ID.ValueString()))
+		return
+	}
+
+	if err := r.client.DisableServerlessBackfillAwait(ctx, nsID); err != nil {
+		// the extension goes away with the cluster.
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("cluster %s not found, the serverless backfill is already disabled", nsID.String()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to disable serverless backfill", err.Error())
+		return
+	}
+}
+
+func (r *ClusterServerlessBackfillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse cluster ID: %s", req.ID))
+		return
+	}
+
+	if _, err := r.client.GetServerlessBackfill(ctx, nsID); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import serverless backfill with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterServerlessBackfillCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		enableErr error
+		waitErr   error
+	}{
+		{
+			name: "enabled",
+		},
+		{
+			name:      "enable rejected",
+			enableErr: errors.New("expected status code 202 but got 400, message: cluster is busy"),
+		},
+		{
+			name:    "wait failed",
+			waitErr: errors.New("the platform reported the cluster as failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx  = context.Background()
+				nsID = uuid.Must(uuid.NewRandom())
+			)
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.
+				EXPECT().
+				EnableServerlessBackfillAwait(ctx, nsID, apigen_mgmtv2.TenantExtensionServerlessBackfillRequest{
+					Resources: apigen_mgmtv2.ComponentResourceRequest{
+						ComponentTypeId: "p-2c8g",
+						Replica:         2,
+					},
+				}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, _ apigen_mgmtv2.TenantExtensionServerlessBackfillRequest, accepted func()) error {
+					if tt.enableErr != nil {
+						return tt.enableErr
+					}
+					accepted()
+					return tt.waitErr
+				})
+			if tt.enableErr == nil && tt.waitErr == nil {
+				client.
+					EXPECT().
+					GetServerlessBackfill(ctx, nsID).
+					Return(&apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody{
+						Resources: &apigen_mgmtv2.ComponentResource{
+							ComponentTypeId: "p-2c8g",
+							Cpu:             "2",
+							Memory:          "8 GB",
+							Replica:         2,
+						},
+						Status: "Running",
+					}, nil)
+			}
+
+			r := &ClusterServerlessBackfillResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"id":                tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"cluster_id":        tftypes.NewValue(tftypes.String, nsID.String()),
+						"component_type_id": tftypes.NewValue(tftypes.String, "p-2c8g"),
+						"replica":           tftypes.NewValue(tftypes.Number, 2),
+						"version":           tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objectType, nil),
+				},
+			}
+			r.Create(ctx, req, resp)
+
+			if tt.enableErr != nil {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+			if tt.waitErr != nil {
+				require.True(t, resp.Diagnostics.HasError())
+				// the extension was accepted, so the id is kept for terraform to taint it.
+				var id types.String
+				require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+				assert.Equal(t, nsID.String(), id.ValueString())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			var data ClusterServerlessBackfillModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, nsID.String(), data.ID.ValueString())
+			assert.Equal(t, int64(2), data.Replica.ValueInt64())
+		})
+	}
+}
+
+func TestClusterServerlessBackfillUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx  = context.Background()
+		nsID = uuid.Must(uuid.NewRandom())
+	)
+
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	client.
+		EXPECT().
+		UpdateServerlessBackfillAwait(ctx, nsID, apigen_mgmtv2.TenantExtensionServerlessBackfillRequest{
+			Resources: apigen_mgmtv2.ComponentResourceRequest{
+				ComponentTypeId: "p-2c8g",
+				Replica:         3,
+			},
+			Version: ptr.Ptr("v2.5.0"),
+		}).
+		Return(nil)
+	client.
+		EXPECT().
+		GetServerlessBackfill(ctx, nsID).
+		Return(&apigen_mgmtv2.GetTenantExtensionServerlessBackfillResponseBody{
+			Resources: &apigen_mgmtv2.ComponentResource{
+				ComponentTypeId: "p-2c8g",
+				Cpu:             "2",
+				Memory:          "8 GB",
+				Replica:         3,
+			},
+			Status: "Running",
+		}, nil)
+
+	r := &ClusterServerlessBackfillResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	newValue := func(componentTypeID string, replica int, version string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, nsID.String()),
+			"cluster_id":        tftypes.NewValue(tftypes.String, nsID.String()),
+			"component_type_id": tftypes.NewValue(tftypes.String, componentTypeID),
+			"replica":           tftypes.NewValue(tftypes.Number, replica),
+			"version":           tftypes.NewValue(tftypes.String, version),
+		})
+	}
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: newValue("p-2c8g", 3, "v2.5.0")},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: newValue("p-1c4g", 1, "v2.4.0")},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: newValue("p-1c4g", 1, "v2.4.0")},
+	}
+	r.Update(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var data ClusterServerlessBackfillModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "p-2c8g", data.ComponentTypeID.ValueString())
+	assert.Equal(t, int64(3), data.Replica.ValueInt64())
+	// the platform does not report the version, the planned one is kept.
+	assert.Equal(t, "v2.5.0", data.Version.ValueString())
+}
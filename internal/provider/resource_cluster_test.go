@@ -3,17 +3,28 @@ package provider
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
 	apigen_mgmtv1 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v1"
 	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
 	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createSimpleTestCluster(t *testing.T, name, region, imageTag string, tier apigen_mgmtv2.TierId, status apigen_mgmtv2.TenantStatus) *apigen_mgmtv2.Tenant {
@@ -54,6 +65,9 @@ func createSimpleTestCluster(t *testing.T, name, region, imageTag string, tier a
 					Replica:         1,
 				},
 			},
+			ComputeCache: apigen_mgmtv2.TenantResourceComputeCache{
+				SizeGb: 20,
+			},
 		},
 	}
 }
@@ -116,6 +130,7 @@ func TestTierValidation(t *testing.T) {
 		name    string
 		tier    string
 		isBYOC  bool
+		isBYOK  bool
 		wantErr bool
 		errMsg  string
 	}{
@@ -155,26 +170,34 @@ func TestTierValidation(t *testing.T) {
 			wantErr: true,
 			errMsg:  "BYOC clusters must use the BYOC tier",
 		},
+		{
+			name:   "BYOK with BYOK tier",
+			tier:   string(apigen_mgmtv2.TierIdBYOK),
+			isBYOK: true,
+		},
+		{
+			name:    "BYOK with BYOC tier",
+			tier:    string(apigen_mgmtv2.TierIdBYOC),
+			isBYOK:  true,
+			wantErr: true,
+			errMsg:  "BYOK clusters must use the BYOK tier",
+		},
+		{
+			name:    "SaaS with BYOK tier",
+			tier:    string(apigen_mgmtv2.TierIdBYOK),
+			wantErr: true,
+			errMsg:  "SaaS clusters must use either Standard or Invited tier",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tier := apigen_mgmtv2.TierId(tt.tier)
-			var hasErr bool
-			var errMsg string
+			var diags diag.Diagnostics
+			validateClusterTier(apigen_mgmtv2.TierId(tt.tier), tt.isBYOC, tt.isBYOK, &diags)
 
-			if tt.isBYOC && tier != apigen_mgmtv2.TierIdBYOC {
-				hasErr = true
-				errMsg = "BYOC clusters must use the BYOC tier"
-			}
-			if !tt.isBYOC && tier != apigen_mgmtv2.TierIdStandard && tier != apigen_mgmtv2.TierIdInvited {
-				hasErr = true
-				errMsg = "SaaS clusters must use either Standard or Invited tier"
-			}
-
-			assert.Equal(t, tt.wantErr, hasErr)
+			assert.Equal(t, tt.wantErr, diags.HasError())
 			if tt.wantErr {
-				assert.Contains(t, errMsg, tt.errMsg)
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.errMsg)
 			}
 		})
 	}
@@ -247,6 +270,17 @@ func TestClusterCreate_previous_creation_failed(t *testing.T) {
 		GetBYOCCluster(ctx, region, "").
 		Return(nil, cloudsdk.ErrBYOCClusterNotFound)
 
+	client.
+		EXPECT().
+		GetComputeCache(ctx, tenant.NsId).
+		Return(&apigen_mgmtv2.GetTenantComputeCacheResponseBody{
+			Current: apigen_mgmtv2.TenantComputeCacheConfig{SizeGb: 20},
+		}, nil)
+	client.
+		EXPECT().
+		GetClusterEndpoint(ctx, tenant.NsId).
+		Return(&apigen_mgmtv2.Endpoint{NsId: tenant.NsId, Host: "test-cluster.risingwave.cloud", Port: 4566, Database: "dev"}, nil)
+
 	p := &ClusterResource{
 		client:     client,
 		dataHelper: dataHelper,
@@ -258,3 +292,834 @@ func TestClusterCreate_previous_creation_failed(t *testing.T) {
 		State: tfsdk.State{},
 	})
 }
+
+func TestClusterCreate_restore_from_snapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx        = context.Background()
+		name       = "test-cluster-clone"
+		region     = "us-west-2"
+		tier       = apigen_mgmtv2.TierIdInvited
+		tierV1     = apigen_mgmtv1.TierId(tier)
+		source     = createSimpleTestCluster(t, "test-cluster", region, "v2.0.5", tier, apigen_mgmtv2.Running)
+		restored   = createSimpleTestCluster(t, name, region, "v2.0.5", tier, apigen_mgmtv2.Running)
+		desired    = createSimpleTestCluster(t, name, region, "v2.1.2", tier, apigen_mgmtv2.Running)
+		snapshotID = uuid.Must(uuid.NewRandom())
+	)
+	desired.NsId = restored.NsId
+
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+
+	dataHelper := NewMockDataExtractHelperInterface(ctrl)
+
+	dataHelper.EXPECT().
+		Get(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, getter DataGetter, target interface{}) diag.Diagnostics {
+			p, ok := target.(*ClusterModel)
+			assert.True(t, ok)
+			clusterToDataModel(desired, nil, p)
+			// the tier is left to the source cluster
+			p.ID = types.StringUnknown()
+			p.Tier = types.StringNull()
+			p.RestoreFrom = types.ObjectValueMust(restoreFromAttrTypes, map[string]attr.Value{
+				"cluster_id":  types.StringValue(source.NsId.String()),
+				"snapshot_id": types.StringValue(snapshotID.String()),
+			})
+			return nil
+		})
+	dataHelper.EXPECT().
+		Set(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, setter DataSetter, val interface{}) diag.Diagnostics {
+			p, ok := val.(*ClusterModel)
+			assert.True(t, ok)
+			assert.Equal(t, restored.NsId.String(), p.ID.ValueString())
+			assert.Equal(t, "v2.1.2", p.Version.ValueString())
+			assert.Equal(t, string(tier), p.Tier.ValueString())
+			assert.False(t, p.RestoreFrom.IsNull())
+			return nil
+		})
+
+	client.
+		EXPECT().
+		GetClusterByNsID(ctx, source.NsId).
+		Return(source, nil)
+
+	client.
+		EXPECT().
+		GetAvailableComponentTypes(ctx, region, tierV1, gomock.Any()).
+		Return([]apigen_mgmtv1.AvailableComponentType{
+			{
+				Id:      "p-1c4g",
+				Maximum: 3,
+				Cpu:     "1",
+				Memory:  "4 GB",
+			},
+		}, nil).
+		Times(4)
+
+	client.
+		EXPECT().
+		GetClusterByRegionAndName(ctx, region, name).
+		Return(nil, cloudsdk.ErrClusterNotFound)
+
+	// the cluster is restored instead of created, then upgraded to the configured version
+	gomock.InOrder(
+		client.
+			EXPECT().
+			RestoreCluster(ctx, source.NsId, snapshotID, name).
+			Return(restored.NsId, nil),
+		client.
+			EXPECT().
+			WaitClusterRunning(ctx, restored.NsId).
+			Return(restored, nil),
+		client.
+			EXPECT().
+			UpdateClusterImageByNsIDAwait(ctx, restored.NsId, "v2.1.2").
+			Return(nil),
+		client.
+			EXPECT().
+			GetClusterByNsID(ctx, restored.NsId).
+			Return(desired, nil),
+	)
+
+	client.
+		EXPECT().
+		GetBYOCCluster(ctx, region, "").
+		Return(nil, cloudsdk.ErrBYOCClusterNotFound)
+
+	// the restored cluster already has the configured compute cache: it is read to reconcile it
+	// and to refresh the state, but not updated
+	client.
+		EXPECT().
+		GetComputeCache(ctx, restored.NsId).
+		Return(&apigen_mgmtv2.GetTenantComputeCacheResponseBody{
+			Current: apigen_mgmtv2.TenantComputeCacheConfig{SizeGb: 20},
+		}, nil).
+		Times(2)
+	client.
+		EXPECT().
+		GetClusterEndpoint(ctx, restored.NsId).
+		Return(&apigen_mgmtv2.Endpoint{NsId: restored.NsId, Host: "restored.risingwave.cloud", Port: 4566, Database: "dev"}, nil)
+
+	p := &ClusterResource{
+		client:     client,
+		dataHelper: dataHelper,
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	p.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	p.Create(ctx, resource.CreateRequest{
+		Plan: tfsdk.Plan{},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+}
+
+func TestClusterCreate_byok(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx     = context.Background()
+		name    = "test-cluster"
+		region  = "us-east-1"
+		tier    = apigen_mgmtv2.TierIdBYOK
+		created = createSimpleTestCluster(t, name, region, "v2.1.2", tier, apigen_mgmtv2.AwaitingConfig)
+	)
+	created.ClusterName = "prod"
+	created.ServiceAccountName = ptr.Ptr("risingwave-test-cluster")
+	configured := *created
+	configured.Status = apigen_mgmtv2.ConfigUpdating
+
+	p := &ClusterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	p.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	var data ClusterModel
+	require.False(t, clusterToDataModel(created, nil, &data).HasError())
+	data.ID = types.StringUnknown()
+	data.EncodedID = types.StringUnknown()
+	data.Tier = types.StringNull()
+	data.ServiceAccountName = types.StringUnknown()
+	data.Endpoint = types.ObjectUnknown(clusterEndpointAttrTypes)
+	data.BYOC = types.ObjectNull(byocAttrTypes)
+	data.BYOK = types.ObjectValueMust(byokAttrTypes, map[string]attr.Value{
+		"env":          types.StringValue("prod"),
+		"iam_role_arn": types.StringValue("arn:aws:iam::123456789012:role/test-cluster"),
+		"metastore": types.ObjectValueMust(byokMetastoreAttrTypes, map[string]attr.Value{
+			"host":                types.StringValue("meta.internal"),
+			"port":                types.Int64Value(5432),
+			"database":            types.StringValue("risingwave"),
+			"username":            types.StringValue("rw"),
+			"password_wo":         types.StringNull(),
+			"password_wo_version": types.Int64Value(1),
+		}),
+	})
+	data.RestoreFrom = types.ObjectNull(restoreFromAttrTypes)
+	data.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttrTypes)
+	data.Extensions = types.ObjectNull(extensionsAttrTypes)
+	data.RestartTrigger = types.MapNull(types.StringType)
+
+	// the password is write-only, so it is only in the configuration
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	require.False(t, config.Set(ctx, &data).HasError())
+	require.False(t, config.SetAttribute(ctx, path.Root("byok").AtName("metastore").AtName("password_wo"), "secret").HasError())
+
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	dataHelper := NewMockDataExtractHelperInterface(ctrl)
+
+	dataHelper.EXPECT().
+		Get(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, getter DataGetter, target interface{}) diag.Diagnostics {
+			*target.(*ClusterModel) = data
+			return nil
+		})
+	dataHelper.EXPECT().
+		Set(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, setter DataSetter, val interface{}) diag.Diagnostics {
+			p, ok := val.(*ClusterModel)
+			assert.True(t, ok)
+			assert.Equal(t, created.NsId.String(), p.ID.ValueString())
+			assert.Equal(t, string(tier), p.Tier.ValueString())
+			assert.Equal(t, "risingwave-test-cluster", p.ServiceAccountName.ValueString())
+			assert.Equal(t, PowerStateRunning, p.PowerState.ValueString())
+			return nil
+		})
+
+	client.
+		EXPECT().
+		GetAvailableComponentTypes(ctx, region, apigen_mgmtv1.TierId(tier), gomock.Any()).
+		Return([]apigen_mgmtv1.AvailableComponentType{
+			{Id: "p-1c4g", Maximum: 3, Cpu: "1", Memory: "4 GB"},
+		}, nil).
+		Times(4)
+	client.
+		EXPECT().
+		GetClusterByRegionAndName(ctx, region, name).
+		Return(nil, cloudsdk.ErrClusterNotFound)
+
+	// the cluster is configured as soon as it awaits its configuration, without waiting for it to
+	// be running
+	gomock.InOrder(
+		client.
+			EXPECT().
+			CreateClusterAwaitingConfig(ctx, region, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, region string, req apigen_mgmtv2.TenantRequestRequestBody) (*apigen_mgmtv2.Tenant, error) {
+				assert.Equal(t, "prod", *req.ClusterName)
+				assert.Equal(t, tier, *req.Tier)
+				return created, nil
+			}),
+		client.
+			EXPECT().
+			UpdateClusterBYOKConfig(ctx, created.NsId, apigen_mgmtv2.PostBYOKTenantConfigRequestBody{
+				Aws: &apigen_mgmtv2.BYOKTenantAWSConfig{
+					IamRoleArn: "arn:aws:iam::123456789012:role/test-cluster",
+				},
+				Metastore: apigen_mgmtv2.BYOKTenantMetastoreConfig{
+					Host:     "meta.internal",
+					Port:     5432,
+					Database: "risingwave",
+					Username: "rw",
+					Password: "secret",
+				},
+			}).
+			Return(&configured, nil),
+		client.
+			EXPECT().
+			GetClusterByNsID(ctx, created.NsId).
+			Return(&configured, nil),
+	)
+
+	client.
+		EXPECT().
+		GetBYOCCluster(ctx, region, "prod").
+		Return(nil, cloudsdk.ErrBYOCClusterNotFound)
+	client.
+		EXPECT().
+		GetComputeCache(ctx, created.NsId).
+		Return(&apigen_mgmtv2.GetTenantComputeCacheResponseBody{
+			Current: apigen_mgmtv2.TenantComputeCacheConfig{SizeGb: 20},
+		}, nil)
+	// the cluster is not deployed before its IAM role is set up, so it has no endpoint yet.
+	client.
+		EXPECT().
+		GetClusterEndpoint(ctx, created.NsId).
+		Return(nil, cloudsdk.ErrClusterNotFound)
+
+	p.client = client
+	p.dataHelper = dataHelper
+
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	p.Create(ctx, resource.CreateRequest{
+		Plan:   tfsdk.Plan{},
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+}
+
+func TestRequiresReplaceIfRestoreFromChanged(t *testing.T) {
+	restoreFrom := func(snapshotID string) types.Object {
+		return types.ObjectValueMust(restoreFromAttrTypes, map[string]attr.Value{
+			"cluster_id":  types.StringValue(uuid.Nil.String()),
+			"snapshot_id": types.StringValue(snapshotID),
+		})
+	}
+	null := types.ObjectNull(restoreFromAttrTypes)
+
+	tests := []struct {
+		name            string
+		state           types.Object
+		plan            types.Object
+		requiresReplace bool
+	}{
+		{
+			name:            "another snapshot",
+			state:           restoreFrom("a"),
+			plan:            restoreFrom("b"),
+			requiresReplace: true,
+		},
+		{
+			name:  "removed",
+			state: restoreFrom("a"),
+			plan:  null,
+		},
+		{
+			name:  "added to an existing cluster",
+			state: null,
+			plan:  restoreFrom("a"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &objectplanmodifier.RequiresReplaceIfFuncResponse{}
+			requiresReplaceIfRestoreFromChanged(context.Background(), planmodifier.ObjectRequest{
+				StateValue: tt.state,
+				PlanValue:  tt.plan,
+			}, resp)
+			assert.Equal(t, tt.requiresReplace, resp.RequiresReplace)
+		})
+	}
+}
+
+func TestCheckRestoreSource(t *testing.T) {
+	source := createSimpleTestCluster(t, "source", "us-west-2", "v2.0.5", apigen_mgmtv2.TierIdInvited, apigen_mgmtv2.Running)
+	source.Resources.MetaStore = &apigen_mgmtv2.TenantResourceMetaStore{Type: apigen_mgmtv2.Postgresql}
+
+	tests := []struct {
+		name    string
+		mutate  func(cluster *apigen_mgmtv2.Tenant)
+		wantErr string
+	}{
+		{
+			name:   "matching",
+			mutate: func(cluster *apigen_mgmtv2.Tenant) {},
+		},
+		{
+			name: "metastore left to the source",
+			mutate: func(cluster *apigen_mgmtv2.Tenant) {
+				cluster.Resources.MetaStore = nil
+			},
+		},
+		{
+			name: "other region",
+			mutate: func(cluster *apigen_mgmtv2.Tenant) {
+				cluster.Region = "us-east-1"
+			},
+			wantErr: "Invalid region for restored cluster",
+		},
+		{
+			name: "other tier",
+			mutate: func(cluster *apigen_mgmtv2.Tenant) {
+				cluster.Tier = apigen_mgmtv2.TierIdStandard
+			},
+			wantErr: "Invalid tier for restored cluster",
+		},
+		{
+			name: "other metastore",
+			mutate: func(cluster *apigen_mgmtv2.Tenant) {
+				cluster.Resources.MetaStore = &apigen_mgmtv2.TenantResourceMetaStore{Type: apigen_mgmtv2.AwsRds}
+			},
+			wantErr: "Invalid metastore for restored cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := *source
+			cluster.TenantName = "clone"
+			tt.mutate(&cluster)
+
+			var diags diag.Diagnostics
+			checkRestoreSource(source, &cluster, &diags)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError())
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, tt.wantErr, diags.Errors()[0].Summary())
+		})
+	}
+}
+
+func TestMaintenanceWindowModel(t *testing.T) {
+	ctx := context.Background()
+
+	configured := types.ObjectValueMust(maintenanceWindowAttrTypes, map[string]attr.Value{
+		"day":           types.Int64Value(6),
+		"hour":          types.Int64Value(22),
+		"minute":        types.Int64Value(30),
+		"duration_mins": types.Int64Value(120),
+		"manual_only":   types.BoolValue(false),
+		"disable_until": types.StringValue("2025-01-02T08:00:00+08:00"),
+	})
+
+	var diags diag.Diagnostics
+	window := maintenanceWindowFromModel(ctx, configured, &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, apigen_mgmtv2.MaintenanceWindowTime{Day: 6, Hour: 22, Minute: 30, DurationMins: 120}, window.MaintenanceWindowTime)
+	assert.False(t, *window.ManualOnly)
+	assert.True(t, window.DisableUntil.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	// the platform returns disable_until in UTC, the configured time zone is kept
+	returned := *window
+	returned.DisableUntil = ptr.Ptr(window.DisableUntil.UTC())
+	assert.True(t, configured.Equal(maintenanceWindowToObject(ctx, &returned, configured)))
+
+	// a different instant is drift
+	returned.DisableUntil = ptr.Ptr(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	var got MaintenanceWindowModel
+	require.False(t, maintenanceWindowToObject(ctx, &returned, configured).As(ctx, &got, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "2025-02-01T00:00:00Z", got.DisableUntil.ValueString())
+
+	// not configured
+	assert.Nil(t, maintenanceWindowFromModel(ctx, types.ObjectNull(maintenanceWindowAttrTypes), &diags))
+	assert.False(t, diags.HasError())
+}
+
+func TestInt64RangeValidator(t *testing.T) {
+	v := int64RangeValidator{min: 0, max: 6}
+	for value, expectErr := range map[int64]bool{-1: true, 0: false, 6: false, 7: true} {
+		resp := &validator.Int64Response{}
+		v.ValidateInt64(context.Background(), validator.Int64Request{
+			Path:        path.Root("day"),
+			ConfigValue: types.Int64Value(value),
+		}, resp)
+		assert.Equal(t, expectErr, resp.Diagnostics.HasError(), "value: %d", value)
+	}
+}
+
+func TestReconcileServerlessCompaction(t *testing.T) {
+	enabled := &apigen_mgmtv2.TenantExtensions{
+		ServerlessCompaction: &apigen_mgmtv2.TenantExtensionServerlessCompaction{
+			Enabled:                      true,
+			MaximumCompactionConcurrency: 4,
+		},
+	}
+	current := &apigen_mgmtv2.GetTenantExtensionCompactionResponseBody{
+		MaximumCompactionConcurrency: ptr.Ptr(4),
+		Status:                       "Running",
+		Version:                      ptr.Ptr("v1.0.0"),
+	}
+
+	tests := []struct {
+		name       string
+		extensions *apigen_mgmtv2.TenantExtensions
+		desired    *apigen_mgmtv2.TenantExtensionServerlessCompactionRequest
+		expect     func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID)
+	}{
+		{
+			name:   "disabled and not configured",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {},
+		},
+		{
+			name:    "enable",
+			desired: &apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 4},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				client.EXPECT().
+					EnableServerlessCompactionAwait(gomock.Any(), nsID, apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 4}).
+					Return(nil)
+			},
+		},
+		{
+			name:       "unchanged, the version is left to the platform",
+			extensions: enabled,
+			desired:    &apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 4},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				client.EXPECT().GetServerlessCompaction(gomock.Any(), nsID).Return(current, nil)
+			},
+		},
+		{
+			name:       "update version",
+			extensions: enabled,
+			desired:    &apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 4, Version: ptr.Ptr("v1.1.0")},
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				client.EXPECT().GetServerlessCompaction(gomock.Any(), nsID).Return(current, nil)
+				client.EXPECT().
+					UpdateServerlessCompactionAwait(gomock.Any(), nsID, apigen_mgmtv2.TenantExtensionServerlessCompactionRequest{MaximumCompactionConcurrency: 4, Version: ptr.Ptr("v1.1.0")}).
+					Return(nil)
+			},
+		},
+		{
+			name:       "disable",
+			extensions: enabled,
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				client.EXPECT().DisableServerlessCompactionAwait(gomock.Any(), nsID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			previous := createSimpleTestCluster(t, "test-cluster", "us-west-2", "v2.0.5", apigen_mgmtv2.TierIdInvited, apigen_mgmtv2.Running)
+			previous.Extensions = tt.extensions
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client, previous.NsId)
+
+			r := &ClusterResource{client: client}
+			var diags diag.Diagnostics
+			r.reconcileServerlessCompaction(context.Background(), previous.NsId, previous, tt.desired, &diags)
+			assert.False(t, diags.HasError(), diags.Errors())
+		})
+	}
+}
+
+func TestValidateComputeCache(t *testing.T) {
+	capabilities := &apigen_mgmtv2.GetTenantComputeCacheCapabilitiesResponseBody{
+		AvailablePerformanceTiers: []apigen_mgmtv2.TenantComputeCachePerformanceTier{
+			{Id: apigen_mgmtv2.ComputeCachePerformanceTierStandard, Default: true},
+		},
+		MinSizeGb:  10,
+		MaxSizeGb:  100,
+		SizeStepGb: 10,
+	}
+
+	tests := []struct {
+		name      string
+		desired   apigen_mgmtv2.PostTenantComputeCacheRequestBody
+		expectErr bool
+	}{
+		{
+			name:    "valid size",
+			desired: apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 40},
+		},
+		{
+			name:      "size below the minimum",
+			desired:   apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 0},
+			expectErr: true,
+		},
+		{
+			name:      "size above the maximum",
+			desired:   apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 110},
+			expectErr: true,
+		},
+		{
+			name:      "size off the step",
+			desired:   apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 25},
+			expectErr: true,
+		},
+		{
+			name: "available tier",
+			desired: apigen_mgmtv2.PostTenantComputeCacheRequestBody{
+				SizeGb:          20,
+				PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierStandard),
+			},
+		},
+		{
+			name: "unavailable tier",
+			desired: apigen_mgmtv2.PostTenantComputeCacheRequestBody{
+				SizeGb:          20,
+				PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierPerformance),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateComputeCache(capabilities, &tt.desired, &diags)
+			assert.Equal(t, tt.expectErr, diags.HasError(), diags)
+		})
+	}
+}
+
+// A new cluster cannot read its capabilities yet, so its compute cache is checked against the
+// tier it is created in.
+func TestClusterModifyPlan_create(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizeGB    int
+		tier      types.String
+		restore   bool
+		expectErr bool
+	}{
+		{
+			name:   "within the tier maximum",
+			sizeGB: 40,
+			tier:   types.StringValue(string(apigen_mgmtv2.TierIdStandard)),
+		},
+		{
+			name:      "above the tier maximum",
+			sizeGB:    200,
+			tier:      types.StringValue(string(apigen_mgmtv2.TierIdStandard)),
+			expectErr: true,
+		},
+		{
+			name:      "above the maximum of the default tier",
+			sizeGB:    200,
+			tier:      types.StringUnknown(),
+			expectErr: true,
+		},
+		{
+			// the mock fails on any call to read the tiers
+			name:    "tier of the restored cluster",
+			sizeGB:  200,
+			tier:    types.StringUnknown(),
+			restore: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx     = context.Background()
+				region  = "us-west-2"
+				cluster = createSimpleTestCluster(t, "test-cluster", region, "v2.0.5", apigen_mgmtv2.TierIdStandard, apigen_mgmtv2.Running)
+			)
+			cluster.Resources.ComputeCache.SizeGb = tt.sizeGB
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			if !tt.restore {
+				client.
+					EXPECT().
+					GetTiers(ctx, region).
+					Return([]apigen_mgmtv1.Tier{
+						{Id: ptr.Ptr(apigen_mgmtv1.TierId(apigen_mgmtv2.TierIdInvited)), MaximumComputeNodeFileCacheSizeGiB: 500},
+						{Id: ptr.Ptr(apigen_mgmtv1.TierId(apigen_mgmtv2.TierIdStandard)), MaximumComputeNodeFileCacheSizeGiB: 100},
+					}, nil)
+			}
+
+			r := &ClusterResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			var data ClusterModel
+			require.False(t, clusterToDataModel(cluster, nil, &data).HasError())
+			data.ID = types.StringUnknown()
+			data.Tier = tt.tier
+			data.BYOC = types.ObjectNull(byocAttrTypes)
+			data.BYOK = types.ObjectNull(byokAttrTypes)
+			data.Endpoint = types.ObjectNull(clusterEndpointAttrTypes)
+			data.RestoreFrom = types.ObjectNull(restoreFromAttrTypes)
+			if tt.restore {
+				data.RestoreFrom = types.ObjectValueMust(restoreFromAttrTypes, map[string]attr.Value{
+					"cluster_id":  types.StringValue(uuid.Must(uuid.NewRandom()).String()),
+					"snapshot_id": types.StringValue(uuid.Must(uuid.NewRandom()).String()),
+				})
+			}
+			data.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttrTypes)
+			data.Extensions = types.ObjectNull(extensionsAttrTypes)
+			data.RestartTrigger = types.MapNull(types.StringType)
+			planState := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			require.False(t, planState.Set(ctx, &data).HasError())
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: planState.Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestComputeCacheChanged(t *testing.T) {
+	current := apigen_mgmtv2.TenantComputeCacheConfig{
+		SizeGb:          20,
+		PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierStandard),
+	}
+
+	assert.False(t, computeCacheChanged(current, &apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 20}))
+	assert.False(t, computeCacheChanged(current, &apigen_mgmtv2.PostTenantComputeCacheRequestBody{
+		SizeGb:          20,
+		PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierStandard),
+	}))
+	assert.True(t, computeCacheChanged(current, &apigen_mgmtv2.PostTenantComputeCacheRequestBody{SizeGb: 30}))
+	assert.True(t, computeCacheChanged(current, &apigen_mgmtv2.PostTenantComputeCacheRequestBody{
+		SizeGb:          20,
+		PerformanceTier: ptr.Ptr(apigen_mgmtv2.ComputeCachePerformanceTierPerformance),
+	}))
+}
+
+func TestClusterPowerState(t *testing.T) {
+	for status, expected := range map[apigen_mgmtv2.TenantStatus]string{
+		apigen_mgmtv2.Running:  PowerStateRunning,
+		apigen_mgmtv2.Updating: PowerStateRunning,
+		apigen_mgmtv2.Starting: PowerStateRunning,
+		apigen_mgmtv2.Stopping: PowerStateStopped,
+		apigen_mgmtv2.Stopped:  PowerStateStopped,
+	} {
+		assert.Equal(t, expected, clusterPowerState(status), "status: %s", status)
+	}
+}
+
+func TestPowerStateValidator(t *testing.T) {
+	for value, expectErr := range map[string]bool{PowerStateRunning: false, PowerStateStopped: false, "paused": true} {
+		resp := &validator.StringResponse{}
+		powerStateValidator{}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("power_state"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		assert.Equal(t, expectErr, resp.Diagnostics.HasError(), "value: %s", value)
+	}
+}
+
+func TestClusterUpdate_power_state(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  apigen_mgmtv2.TenantStatus
+		version string
+		expect  func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID)
+	}{
+		{
+			// the mock fails on any call to start, stop or restart the cluster
+			name:    "restart trigger of a stopped cluster",
+			status:  apigen_mgmtv2.Stopped,
+			version: "v2.0.5",
+			expect:  func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {},
+		},
+		{
+			name:    "version of a stopped cluster",
+			status:  apigen_mgmtv2.Stopped,
+			version: "v2.1.2",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				gomock.InOrder(
+					client.EXPECT().StartClusterAwait(gomock.Any(), nsID).Return(nil),
+					client.EXPECT().UpdateClusterImageByNsIDAwait(gomock.Any(), nsID, "v2.1.2").Return(nil),
+					client.EXPECT().StopClusterAwait(gomock.Any(), nsID).Return(nil),
+				)
+			},
+		},
+		{
+			name:    "restart trigger of a running cluster",
+			status:  apigen_mgmtv2.Running,
+			version: "v2.0.5",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface, nsID uuid.UUID) {
+				client.EXPECT().RestartClusterAwait(gomock.Any(), nsID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var (
+				ctx     = context.Background()
+				region  = "us-west-2"
+				tier    = apigen_mgmtv2.TierIdStandard
+				cluster = createSimpleTestCluster(t, "test-cluster", region, "v2.0.5", tier, tt.status)
+			)
+
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.
+				EXPECT().
+				GetClusterByNsID(ctx, cluster.NsId).
+				Return(cluster, nil).
+				Times(2)
+			client.
+				EXPECT().
+				GetAvailableComponentTypes(ctx, region, apigen_mgmtv1.TierId(tier), gomock.Any()).
+				Return([]apigen_mgmtv1.AvailableComponentType{
+					{Id: "p-1c4g", Maximum: 3, Cpu: "1", Memory: "4 GB"},
+				}, nil).
+				AnyTimes()
+			client.
+				EXPECT().
+				GetBYOCCluster(ctx, region, "").
+				Return(nil, cloudsdk.ErrBYOCClusterNotFound)
+			client.
+				EXPECT().
+				GetComputeCache(ctx, cluster.NsId).
+				Return(&apigen_mgmtv2.GetTenantComputeCacheResponseBody{
+					Current: apigen_mgmtv2.TenantComputeCacheConfig{SizeGb: 20},
+				}, nil).
+				AnyTimes()
+			client.
+				EXPECT().
+				GetClusterEndpoint(ctx, cluster.NsId).
+				Return(&apigen_mgmtv2.Endpoint{NsId: cluster.NsId, Host: "test-cluster.risingwave.cloud", Port: 4566, Database: "dev"}, nil).
+				AnyTimes()
+			tt.expect(client, cluster.NsId)
+
+			r := &ClusterResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			var data ClusterModel
+			require.False(t, clusterToDataModel(cluster, nil, &data).HasError())
+			data.BYOC = types.ObjectNull(byocAttrTypes)
+			data.BYOK = types.ObjectNull(byokAttrTypes)
+			data.Endpoint = types.ObjectNull(clusterEndpointAttrTypes)
+			data.RestoreFrom = types.ObjectNull(restoreFromAttrTypes)
+			data.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttrTypes)
+			data.Extensions = types.ObjectNull(extensionsAttrTypes)
+			data.RestartTrigger = types.MapValueMust(types.StringType, map[string]attr.Value{
+				"reason": types.StringValue("first"),
+			})
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			require.False(t, state.Set(ctx, &data).HasError())
+
+			data.Version = types.StringValue(tt.version)
+			data.RestartTrigger = types.MapValueMust(types.StringType, map[string]attr.Value{
+				"reason": types.StringValue("second"),
+			})
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			planState := tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw}
+			require.False(t, planState.Set(ctx, &data).HasError())
+			plan.Raw = planState.Raw
+
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+		})
+	}
+}
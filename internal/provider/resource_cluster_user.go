@@ -48,12 +48,12 @@ type ClusterUserModel struct {
 	CanLogin          types.Bool   `tfsdk:"can_login"`
 }
 
-// readWriteOnlyPassword returns the write-only password from the configuration. Write-only
+// readWriteOnlyValue returns the write-only attribute from the configuration. Write-only
 // values only exist there, never in the plan or the state.
-func readWriteOnlyPassword(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) string {
-	var password types.String
-	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &password)...)
-	return password.ValueString()
+func readWriteOnlyValue(ctx context.Context, config tfsdk.Config, attr path.Path, diags *diag.Diagnostics) string {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, attr, &value)...)
+	return value.ValueString()
 }
 
 func (r *ClusterUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -210,7 +210,7 @@ func (r *ClusterUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	// the write-only password never reaches the plan, only the configuration holds it.
 	if data.Password.IsNull() {
-		password = readWriteOnlyPassword(ctx, req.Config, &resp.Diagnostics)
+		password = readWriteOnlyValue(ctx, req.Config, path.Root("password_wo"), &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -371,7 +371,7 @@ func (r *ClusterUserResource) Update(ctx context.Context, req resource.UpdateReq
 
 	switch {
 	case !data.PasswordWOVersion.Equal(state.PasswordWOVersion):
-		password := readWriteOnlyPassword(ctx, req.Config, &resp.Diagnostics)
+		password := readWriteOnlyValue(ctx, req.Config, path.Root("password_wo"), &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
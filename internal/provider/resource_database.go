@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatabaseResource{}
+var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseResource{}
+
+func NewDatabaseResource() resource.Resource {
+	return &DatabaseResource{}
+}
+
+// DatabaseResource manages a database in a cluster along with the resource group its streaming
+// jobs run in. The platform cannot move a database to another resource group, so every attribute
+// replaces the database.
+type DatabaseResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type DatabaseModel struct {
+	// [cluster ID].[database name]
+	ID            types.String `tfsdk:"id"`
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	Name          types.String `tfsdk:"name"`
+	ResourceGroup types.String `tfsdk:"resource_group"`
+}
+
+func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A database in a RisingWave cluster, bound to the resource group its streaming jobs run in.",
+		MarkdownDescription: databaseMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [cluster ID].[database name]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database, unique within the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_group": schema.StringAttribute{
+				MarkdownDescription: "The resource group the streaming jobs of the database run in, e.g. the `name` of a " +
+					"`risingwavecloud_cluster_resource_group` resource. Defaults to `" + defaultResourceGroup + "`. " +
+					"The database cannot be moved to another resource group, so changing it replaces the database.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultResourceGroup),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the resource group of a new database exists, so that a typo fails the
+// plan instead of the apply. A resource group created by the same apply does not exist yet either,
+// it has to be applied first.
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy, and an existing database keeps its resource group.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var data DatabaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the cluster may be created by the same apply.
+	if data.ClusterID.IsUnknown() || data.ResourceGroup.IsUnknown() || data.ResourceGroup.ValueString() == defaultResourceGroup {
+		return
+	}
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_id"), "cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	if _, err := r.client.GetResourceGroup(ctx, nsID, data.ResourceGroup.ValueString()); err != nil {
+		if errors.Is(err, cloudsdk.ErrResourceGroupNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resource_group"),
+				"Resource group not found",
+				fmt.Sprintf(
+					"The resource group %s does not exist in cluster %s. A resource group managed by the same configuration has to be created first, e.g. with terraform apply -target.",
+					data.ResourceGroup.ValueString(), nsID,
+				),
+			)
+			return
+		}
+		// the cluster is not found or not ready, which Create reports better.
+		tflog.Warn(ctx, fmt.Sprintf("cannot check the resource group of database %s: %s", data.Name.ValueString(), err.Error()))
+	}
+}
+
+func databaseToDataModel(clusterNsID uuid.UUID, database *apigen_mgmtv2.Database, data *DatabaseModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", clusterNsID.String(), database.Name))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.Name = types.StringValue(database.Name)
+	data.ResourceGroup = types.StringValue(database.ResourceGroup)
+	// a database created in SQL without a resource group runs in the default one.
+	if database.ResourceGroup == "" {
+		data.ResourceGroup = types.StringValue(defaultResourceGroup)
+	}
+}
+
+// parseDatabaseIdentifier splits `[cluster ID].[database name]` at the first dot. A cluster ID
+// has none, so a database name may contain dots.
+func parseDatabaseIdentifier(databaseResourceID string, diags *diag.Diagnostics) (nsID uuid.UUID, name string) {
+	clusterID, name, ok := strings.Cut(databaseResourceID, ".")
+	if !ok || len(name) == 0 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse database ID: %s", databaseResourceID))
+		return
+	}
+	var err error
+	nsID, err = uuid.Parse(clusterID)
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract cluster ID from database ID: %s", databaseResourceID))
+		return
+	}
+	return
+}
+
+func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DatabaseModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		name          = data.Name.ValueString()
+		resourceGroup = data.ResourceGroup.ValueString()
+	)
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	if resourceGroup != defaultResourceGroup {
+		if _, err := r.client.GetResourceGroup(ctx, nsID, resourceGroup); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("resource_group"), "Unable to read resource group", err.Error())
+			return
+		}
+	}
+
+	database, err := r.client.CreateDatabase(ctx, nsID, name, resourceGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create database", err.Error())
+		return
+	}
+
+	databaseToDataModel(nsID, database, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("database created, name: %s", name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, name := parseDatabaseIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, err := r.client.GetDatabase(ctx, nsID, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("database %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read database", err.Error())
+		return
+	}
+
+	databaseToDataModel(nsID, database, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a change, every attribute requires a replacement.
+func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DatabaseModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DatabaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, name := parseDatabaseIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteDatabase(ctx, nsID, name); err != nil {
+		// the database goes away with the cluster.
+		if errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("cluster %s not found, the database %s is already gone", nsID, name))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to delete database", err.Error())
+		return
+	}
+}
+
+func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, name := parseDatabaseIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetDatabase(ctx, nsID, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import database with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
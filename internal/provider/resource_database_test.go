@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDatabaseIdentifier(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	var diags diag.Diagnostics
+	parsedNsID, name := parseDatabaseIdentifier(nsID.String()+".sales.eu", &diags)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, nsID, parsedNsID)
+	assert.Equal(t, "sales.eu", name)
+
+	for _, id := range []string{nsID.String(), nsID.String() + ".", "not-a-uuid.sales"} {
+		var diags diag.Diagnostics
+		parseDatabaseIdentifier(id, &diags)
+		assert.True(t, diags.HasError(), "id: %s", id)
+	}
+}
+
+func TestDatabaseModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name          string
+		resourceGroup string
+		expect        func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectErr     bool
+	}{
+		{
+			name:          "default resource group",
+			resourceGroup: defaultResourceGroup,
+			expect:        func(client *cloudsdk_mock.MockCloudClientInterface) {},
+		},
+		{
+			name:          "existing resource group",
+			resourceGroup: "analytics",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().
+					GetResourceGroup(gomock.Any(), nsID, "analytics").
+					Return(&apigen_mgmtv2.ResourceGroupDetails{Name: "analytics"}, nil)
+			},
+		},
+		{
+			name:          "missing resource group",
+			resourceGroup: "analytics",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().
+					GetResourceGroup(gomock.Any(), nsID, "analytics").
+					Return(nil, errors.Wrap(cloudsdk.ErrResourceGroupNotFound, "analytics"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &DatabaseResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"cluster_id":     tftypes.NewValue(tftypes.String, nsID.String()),
+				"name":           tftypes.NewValue(tftypes.String, "sales"),
+				"resource_group": tftypes.NewValue(tftypes.String, tt.resourceGroup),
+			})
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}, resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+		})
+	}
+}
@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaterializedViewResource{}
+var _ resource.ResourceWithImportState = &MaterializedViewResource{}
+
+const defaultSchema = "public"
+
+func NewMaterializedViewResource() resource.Resource {
+	return &MaterializedViewResource{}
+}
+
+// MaterializedViewResource manages a materialized view, and waits for it to backfill the existing
+// data of its upstreams before reporting it as created.
+type MaterializedViewResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type MaterializedViewModel struct {
+	// [cluster ID].[database name].[schema].[name]
+	ID         types.String `tfsdk:"id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	Database   types.String `tfsdk:"database"`
+	Schema     types.String `tfsdk:"schema"`
+	Name       types.String `tfsdk:"name"`
+	Definition types.String `tfsdk:"definition"`
+	// the definition as RisingWave records it, which is normalized and so never equal to the
+	// configured one. It is what drift is detected against.
+	CatalogDefinition types.String `tfsdk:"catalog_definition"`
+	BackfillTimeout   types.String `tfsdk:"backfill_timeout"`
+	Owner             types.String `tfsdk:"owner"`
+}
+
+// durationValidator checks that a string attribute is a positive Go duration, e.g. "30m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration, e.g. 30m or 2h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("Expected a positive duration, e.g. 30m or 2h, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// defaultSchemaValidator only accepts the default schema. The API lists the downstreams of
+// materialized views, sources and sinks and drops them by name alone, and tracks the backfill of a
+// materialized view the same way, so in any other schema it could act on an object of the same
+// name in the default one.
+type defaultSchemaValidator struct {
+	// the objects the schema is of, e.g. "Materialized views"
+	objects string
+}
+
+func (v defaultSchemaValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be %q", defaultSchema)
+}
+
+func (v defaultSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v defaultSchemaValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == defaultSchema {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Unsupported schema",
+		unsupportedSchemaMessage(v.objects, req.ConfigValue.ValueString()),
+	)
+}
+
+func unsupportedSchemaMessage(objects, schema string) string {
+	return fmt.Sprintf("%s can only be managed in the %q schema, got: %q", objects, defaultSchema, schema)
+}
+
+func (r *MaterializedViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_materialized_view"
+}
+
+func (r *MaterializedViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A materialized view in a database of a RisingWave cluster.",
+		MarkdownDescription: materializedViewMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [cluster ID].[database name].[schema].[name]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database the materialized view is created in, e.g. the `name` of a " +
+					"`risingwavecloud_database` resource.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The schema the materialized view is created in. Defaults to `" + defaultSchema + "`, " +
+					"which is the only schema supported.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultSchema),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					defaultSchemaValidator{objects: "Materialized views"},
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the materialized view. It is quoted, so it is case-sensitive.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				MarkdownDescription: "The query of the materialized view, i.e. what follows `AS` in " +
+					"`CREATE MATERIALIZED VIEW`. Changing it replaces the materialized view, which backfills again.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_definition": schema.StringAttribute{
+				MarkdownDescription: "The definition of the materialized view as recorded by RisingWave. It is normalized, " +
+					"so it differs from `definition` in formatting.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backfill_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf(
+					"How long to wait for the materialized view to backfill the existing data of its upstreams when it "+
+						"is created, e.g. `2h`. Defaults to `%s`.", cloudsdk.PollingMatViewBackfill.Timeout,
+				),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(cloudsdk.PollingMatViewBackfill.Timeout.String()),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The database user owning the materialized view.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MaterializedViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// quoteIdentifier quotes a SQL identifier, so that it is taken as is.
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+// matViewQueryPattern extracts the query from the definition RisingWave records, which is the
+// whole CREATE statement.
+var matViewQueryPattern = regexp.MustCompile(`(?is)^\s*CREATE\s+MATERIALIZED\s+VIEW\s+.+?\s+AS\s+(.+?);?\s*$`)
+
+// matViewQuery returns the query of a materialized view, or the whole definition if it cannot be
+// parsed.
+func matViewQuery(catalogDefinition string) string {
+	if m := matViewQueryPattern.FindStringSubmatch(catalogDefinition); m != nil {
+		return m[1]
+	}
+	return catalogDefinition
+}
+
+func matViewToDataModel(clusterNsID uuid.UUID, database string, mv *apigen_mgmtv2.MatView, data *MaterializedViewModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s.%s", clusterNsID.String(), database, mv.MatViewSchema, mv.MatViewName))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.Database = types.StringValue(database)
+	data.Schema = types.StringValue(mv.MatViewSchema)
+	data.Name = types.StringValue(mv.MatViewName)
+	data.Owner = types.StringValue(mv.MatViewOwner)
+
+	switch {
+	case data.Definition.IsNull():
+		// imported, the configured query is not known yet.
+		data.Definition = types.StringValue(matViewQuery(mv.Definition))
+	case !data.CatalogDefinition.IsNull() && data.CatalogDefinition.ValueString() != mv.Definition:
+		// the materialized view was recreated outside of terraform. Reporting what RisingWave
+		// has as the definition makes the plan replace it with the configured one.
+		data.Definition = types.StringValue(matViewQuery(mv.Definition))
+	}
+	data.CatalogDefinition = types.StringValue(mv.Definition)
+}
+
+// parseSchemaObjectIdentifier splits `[cluster ID].[database name].[schema].[name]`, the identifier
+// of the objects in a schema, e.g. a materialized view. kind names the object in diagnostics. Like
+// in parseSecretIdentifier, only the database name may contain dots.
+func parseSchemaObjectIdentifier(resourceID, kind string, diags *diag.Diagnostics) (nsID uuid.UUID, database, schema, name string) {
+	clusterID, rest, ok := strings.Cut(resourceID, ".")
+	nameIdx := strings.LastIndex(rest, ".")
+	schemaIdx := -1
+	if nameIdx > 0 {
+		schemaIdx = strings.LastIndex(rest[:nameIdx], ".")
+	}
+	if !ok || schemaIdx <= 0 || schemaIdx == nameIdx-1 || nameIdx == len(rest)-1 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse %s ID: %s", kind, resourceID))
+		return
+	}
+	var err error
+	nsID, err = uuid.Parse(clusterID)
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract cluster ID from %s ID: %s", kind, resourceID))
+		return
+	}
+	database, schema, name = rest[:schemaIdx], rest[schemaIdx+1:nameIdx], rest[nameIdx+1:]
+	return
+}
+
+func (r *MaterializedViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaterializedViewModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		database = data.Database.ValueString()
+		schema   = data.Schema.ValueString()
+		name     = data.Name.ValueString()
+	)
+
+	if strings.Contains(schema, ".") || strings.Contains(name, ".") {
+		resp.Diagnostics.AddError("Invalid name", "The schema and the name of a materialized view cannot contain dots")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	// the validator already checked the duration.
+	timeout, _ := time.ParseDuration(data.BackfillTimeout.ValueString())
+
+	// The statements of a batch share a session. With background DDL the statement returns as soon
+	// as the materialized view is created, instead of holding the request open for the whole
+	// backfill, and the progress is polled instead.
+	statements := []string{
+		"SET BACKGROUND_DDL = true",
+		fmt.Sprintf("CREATE MATERIALIZED VIEW %s.%s AS %s", quoteIdentifier(schema), quoteIdentifier(name), data.Definition.ValueString()),
+	}
+	results, err := r.client.ExecuteSQLBatch(ctx, nsID, database, statements)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create materialized view", err.Error())
+		return
+	}
+	if addBatchSQLDiagnostics(statements, results, "Unable to create materialized view", &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s.%s", nsID.String(), database, schema, name))
+
+	if err := r.client.WaitMatViewBackfilled(ctx, nsID, database, name, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to wait for the materialized view to backfill",
+			fmt.Sprintf(
+				"The materialized view %s.%s was created but is not usable yet: %s\n\nIt is marked as tainted and the next "+
+					"apply replaces it. If it only needs more time, increase backfill_timeout or run `terraform untaint` "+
+					"once the backfill completes.",
+				schema, name, err.Error(),
+			),
+		)
+		// saving the state along with the error taints the resource. Otherwise terraform would lose
+		// track of a materialized view that keeps backfilling.
+		data.CatalogDefinition = types.StringNull()
+		data.Owner = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	mv, err := r.client.GetMatView(ctx, nsID, database, schema, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read materialized view", err.Error())
+		return
+	}
+
+	matViewToDataModel(nsID, database, mv, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("materialized view created, name: %s.%s", schema, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaterializedViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaterializedViewModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "materialized view", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mv, err := r.client.GetMatView(ctx, nsID, database, schema, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrMatViewNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("materialized view %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read materialized view", err.Error())
+		return
+	}
+
+	matViewToDataModel(nsID, database, mv, &data)
+	// imported
+	if data.BackfillTimeout.IsNull() {
+		data.BackfillTimeout = types.StringValue(cloudsdk.PollingMatViewBackfill.Timeout.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores backfill_timeout, every other change requires a replacement.
+func (r *MaterializedViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MaterializedViewModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete refuses to drop a materialized view that others read from. It is checked here rather
+// than while planning, since terraform destroys the objects reading from it first.
+func (r *MaterializedViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MaterializedViewModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "materialized view", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	downstreams, err := r.client.GetMatViewDownstreams(ctx, nsID, database, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrMatViewNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("materialized view %s not found, it is already gone", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read materialized view dependencies", err.Error())
+		return
+	}
+	if len(downstreams) > 0 {
+		resp.Diagnostics.AddError(
+			"Materialized view is in use",
+			fmt.Sprintf(
+				"The materialized view %s.%s in database %s is read by the objects below. Drop them, or remove them "+
+					"from the configuration, before deleting or replacing the materialized view.\n\n%s",
+				schema, name, database, formatDependencies(downstreams),
+			),
+		)
+		return
+	}
+
+	if err := r.client.DeleteMatView(ctx, nsID, database, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete materialized view", err.Error())
+		return
+	}
+}
+
+func (r *MaterializedViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, database, schema, name := parseSchemaObjectIdentifier(req.ID, "materialized view", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if schema != defaultSchema {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to import materialized view with ID: %s", req.ID),
+			unsupportedSchemaMessage("Materialized views", schema),
+		)
+		return
+	}
+
+	if _, err := r.client.GetMatView(ctx, nsID, database, schema, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import materialized view with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
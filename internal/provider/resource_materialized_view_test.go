@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchemaObjectIdentifier(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	var diags diag.Diagnostics
+	parsedNsID, database, schema, name := parseSchemaObjectIdentifier(nsID.String()+".sales.eu.public.revenue", "materialized view", &diags)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, nsID, parsedNsID)
+	assert.Equal(t, "sales.eu", database)
+	assert.Equal(t, "public", schema)
+	assert.Equal(t, "revenue", name)
+
+	for _, id := range []string{
+		nsID.String() + ".dev.revenue",
+		nsID.String() + ".dev.public.",
+		nsID.String() + ".dev..revenue",
+		nsID.String() + "..public.revenue",
+		"not-a-uuid.dev.public.revenue",
+	} {
+		var diags diag.Diagnostics
+		parseSchemaObjectIdentifier(id, "materialized view", &diags)
+		assert.True(t, diags.HasError(), "id: %s", id)
+	}
+}
+
+func TestMatViewSchemaValidator(t *testing.T) {
+	for value, expectErr := range map[string]bool{defaultSchema: false, "analytics": true, "Public": true} {
+		resp := &validator.StringResponse{}
+		defaultSchemaValidator{objects: "Materialized views"}.ValidateString(context.Background(), validator.StringRequest{
+			Path:        path.Root("schema"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		assert.Equal(t, expectErr, resp.Diagnostics.HasError(), "value: %s", value)
+	}
+}
+
+func TestMatViewToDataModel(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+	mv := &apigen_mgmtv2.MatView{
+		MatViewSchema: "public",
+		MatViewName:   "revenue",
+		MatViewOwner:  "root",
+		Definition:    `CREATE MATERIALIZED VIEW "public"."revenue" AS SELECT SUM(amount) FROM orders`,
+	}
+
+	// imported
+	data := MaterializedViewModel{}
+	matViewToDataModel(nsID, "dev", mv, &data)
+	assert.Equal(t, "SELECT SUM(amount) FROM orders", data.Definition.ValueString())
+	assert.Equal(t, mv.Definition, data.CatalogDefinition.ValueString())
+	assert.Equal(t, nsID.String()+".dev.public.revenue", data.ID.ValueString())
+
+	// unchanged, the configured formatting is kept
+	data = MaterializedViewModel{
+		Definition:        types.StringValue("select sum(amount)\nfrom orders"),
+		CatalogDefinition: types.StringValue(mv.Definition),
+	}
+	matViewToDataModel(nsID, "dev", mv, &data)
+	assert.Equal(t, "select sum(amount)\nfrom orders", data.Definition.ValueString())
+
+	// recreated outside of terraform
+	data = MaterializedViewModel{
+		Definition:        types.StringValue("select sum(amount)\nfrom orders"),
+		CatalogDefinition: types.StringValue(`CREATE MATERIALIZED VIEW "public"."revenue" AS SELECT COUNT(*) FROM orders`),
+	}
+	matViewToDataModel(nsID, "dev", mv, &data)
+	assert.Equal(t, "SELECT SUM(amount) FROM orders", data.Definition.ValueString())
+	assert.Equal(t, mv.Definition, data.CatalogDefinition.ValueString())
+}
+
+func TestMaterializedViewCreateWaitsForBackfill(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		waitErr     error
+		expectError bool
+	}{
+		{name: "backfilled"},
+		{name: "backfill timed out", waitErr: wait.ErrWaitTimeout, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+
+			statements := []string{
+				"SET BACKGROUND_DDL = true",
+				`CREATE MATERIALIZED VIEW "public"."revenue" AS SELECT SUM(amount) FROM orders`,
+			}
+			gomock.InOrder(
+				client.EXPECT().ExecuteSQLBatch(gomock.Any(), nsID, "dev", statements).Return([]apigen_mgmtv2.BatchQueryResult{
+					{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+					{Query: statements[1], Result: &apigen_mgmtv2.QueryResult{}},
+				}, nil),
+				client.EXPECT().WaitMatViewBackfilled(gomock.Any(), nsID, "dev", "revenue", 2*time.Hour).Return(tt.waitErr),
+			)
+			if tt.waitErr == nil {
+				client.EXPECT().GetMatView(gomock.Any(), nsID, "dev", "public", "revenue").Return(&apigen_mgmtv2.MatView{
+					MatViewSchema: "public",
+					MatViewName:   "revenue",
+					MatViewOwner:  "root",
+					Definition:    statements[1],
+				}, nil)
+			}
+
+			r := &MaterializedViewResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"cluster_id":         tftypes.NewValue(tftypes.String, nsID.String()),
+				"database":           tftypes.NewValue(tftypes.String, "dev"),
+				"schema":             tftypes.NewValue(tftypes.String, "public"),
+				"name":               tftypes.NewValue(tftypes.String, "revenue"),
+				"definition":         tftypes.NewValue(tftypes.String, "SELECT SUM(amount) FROM orders"),
+				"catalog_definition": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"backfill_timeout":   tftypes.NewValue(tftypes.String, "2h"),
+				"owner":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			r.Create(ctx, resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			// a materialized view that is still backfilling is kept in the state to be tainted.
+			var state MaterializedViewModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, nsID.String()+".dev.public.revenue", state.ID.ValueString())
+		})
+	}
+}
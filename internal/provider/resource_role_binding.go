@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleBindingResource{}
+var _ resource.ResourceWithImportState = &RoleBindingResource{}
+
+func NewRoleBindingResource() resource.Resource {
+	return &RoleBindingResource{}
+}
+
+type RoleBindingResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type RoleBindingModel struct {
+	ID            types.String `tfsdk:"id"`
+	Principal     types.String `tfsdk:"principal"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	RoleID        types.String `tfsdk:"role_id"`
+}
+
+func (r *RoleBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_binding"
+}
+
+func (r *RoleBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A role bound to a principal of the RisingWave Cloud organization, keeping the other bindings.",
+		MarkdownDescription: roleBindingMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource in format of `[principal].[role ID]`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the principal, i.e. a user or a service account.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the principal, as the RisingWave Cloud API reports it.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfPrincipalTypeChanged,
+						"Changing the principal type replaces the binding, setting it after an import does not.",
+						"Changing the principal type replaces the binding, setting it after an import does not.",
+					),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the role bound to the principal.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// requiresReplaceIfPrincipalTypeChanged does not replace an imported binding whose principal type
+// the API did not report.
+func requiresReplaceIfPrincipalTypeChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *RoleBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// parseRoleBindingIdentifier splits `[principal].[role ID]`.
+func parseRoleBindingIdentifier(id string, diags *diag.Diagnostics) (principal, roleID uuid.UUID) {
+	arr := strings.Split(id, ".")
+	if len(arr) != 2 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse role binding ID: %s, expected format: [principal].[role ID]", id))
+		return
+	}
+	var err error
+	principal, err = uuid.Parse(arr[0])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract principal from role binding ID: %s", id))
+		return
+	}
+	roleID, err = uuid.Parse(arr[1])
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract role ID from role binding ID: %s", id))
+		return
+	}
+	return
+}
+
+func (r *RoleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleBindingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal, err := uuid.Parse(data.Principal.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("principal"), "principal is invalid", fmt.Sprintf("Cannot parse principal %s", data.Principal.String()))
+		return
+	}
+	roleID, err := uuid.Parse(data.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "role_id is invalid", fmt.Sprintf("Cannot parse role ID %s", data.RoleID.String()))
+		return
+	}
+
+	if err := r.client.AddRoleBinding(ctx, principal, data.PrincipalType.ValueString(), roleID); err != nil {
+		resp.Diagnostics.AddError("Unable to bind the role", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", principal, roleID))
+
+	tflog.Info(ctx, fmt.Sprintf("role %s bound to %s", roleID, principal))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleBindingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal, roleID := parseRoleBindingIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings, err := r.client.GetRoleBindings(ctx, &principal)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the role bindings", err.Error())
+		return
+	}
+
+	for _, b := range bindings {
+		if b.RoleId != roleID {
+			continue
+		}
+		data.Principal = types.StringValue(principal.String())
+		data.RoleID = types.StringValue(roleID.String())
+		if b.PrincipalType != nil {
+			data.PrincipalType = types.StringValue(*b.PrincipalType)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("role %s is not bound to %s, removing the binding from the state", roleID, principal))
+	resp.State.RemoveResource(ctx)
+}
+
+// Update only records the plan: the principal type set after an import is the only change that
+// does not replace the binding.
+func (r *RoleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleBindingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleBindingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal, roleID := parseRoleBindingIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RemoveRoleBinding(ctx, principal, data.PrincipalType.ValueString(), roleID); err != nil {
+		resp.Diagnostics.AddError("Unable to unbind the role", err.Error())
+		return
+	}
+}
+
+func (r *RoleBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	principal, roleID := parseRoleBindingIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings, err := r.client.GetRoleBindings(ctx, &principal)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import role binding with ID: %s", req.ID), err.Error())
+		return
+	}
+	for _, b := range bindings {
+		if b.RoleId == roleID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError(fmt.Sprintf("Unable to import role binding with ID: %s", req.ID), fmt.Sprintf("role %s is not bound to %s", roleID, principal))
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoleBindingIdentifier(t *testing.T) {
+	principal := uuid.Must(uuid.NewRandom())
+	roleID := uuid.Must(uuid.NewRandom())
+
+	var diags diag.Diagnostics
+	gotPrincipal, gotRoleID := parseRoleBindingIdentifier(principal.String()+"."+roleID.String(), &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, principal, gotPrincipal)
+	assert.Equal(t, roleID, gotRoleID)
+
+	parseRoleBindingIdentifier(principal.String(), &diags)
+	assert.True(t, diags.HasError())
+}
+
+func TestRoleBindingRead(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		principal     = uuid.Must(uuid.NewRandom())
+		roleID        = uuid.Must(uuid.NewRandom())
+		otherRoleID   = uuid.Must(uuid.NewRandom())
+		principalType = "ServiceAccount"
+	)
+
+	r := &RoleBindingResource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	// the state right after an import only has the ID.
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, principal.String()+"."+roleID.String()),
+		"principal":      tftypes.NewValue(tftypes.String, nil),
+		"principal_type": tftypes.NewValue(tftypes.String, nil),
+		"role_id":        tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	tests := []struct {
+		name          string
+		bindings      []apigen_accv2.RoleBinding
+		expectRemoved bool
+	}{
+		{
+			name: "bound",
+			bindings: []apigen_accv2.RoleBinding{
+				{Principal: principal, PrincipalType: &principalType, RoleId: otherRoleID},
+				{Principal: principal, PrincipalType: &principalType, RoleId: roleID},
+			},
+		},
+		{
+			name: "unbound elsewhere",
+			bindings: []apigen_accv2.RoleBinding{
+				{Principal: principal, PrincipalType: &principalType, RoleId: otherRoleID},
+			},
+			expectRemoved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.EXPECT().GetRoleBindings(gomock.Any(), &principal).Return(tt.bindings, nil)
+
+			r := &RoleBindingResource{client: client}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+			if tt.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+				return
+			}
+			var data RoleBindingModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, principal.String(), data.Principal.ValueString())
+			assert.Equal(t, principalType, data.PrincipalType.ValueString())
+			assert.Equal(t, roleID.String(), data.RoleID.ValueString())
+		})
+	}
+}
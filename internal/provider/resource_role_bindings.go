@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleBindingsResource{}
+var _ resource.ResourceWithImportState = &RoleBindingsResource{}
+
+// roleBindingsID is the ID of the resource: the API key belongs to one organization, which has
+// one set of role bindings.
+const roleBindingsID = "org"
+
+func NewRoleBindingsResource() resource.Resource {
+	return &RoleBindingsResource{}
+}
+
+type RoleBindingsResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type RoleBindingsModel struct {
+	ID       types.String `tfsdk:"id"`
+	Bindings types.Set    `tfsdk:"bindings"`
+}
+
+type RoleBindingsEntryModel struct {
+	Principal     types.String `tfsdk:"principal"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	RoleID        types.String `tfsdk:"role_id"`
+}
+
+var roleBindingsEntryAttrTypes = map[string]attr.Type{
+	"principal":      types.StringType,
+	"principal_type": types.StringType,
+	"role_id":        types.StringType,
+}
+
+// principalRoles are the roles bound to one principal, the unit the API updates.
+type principalRoles struct {
+	principalType string
+	roleIDs       map[uuid.UUID]bool
+}
+
+func (p principalRoles) sortedRoleIDs() []uuid.UUID {
+	rtn := make([]uuid.UUID, 0, len(p.roleIDs))
+	for roleID := range p.roleIDs {
+		rtn = append(rtn, roleID)
+	}
+	sort.Slice(rtn, func(i, j int) bool { return rtn[i].String() < rtn[j].String() })
+	return rtn
+}
+
+// covers reports whether p has every role of other.
+func (p principalRoles) covers(other principalRoles) bool {
+	for roleID := range other.roleIDs {
+		if !p.roleIDs[roleID] {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *RoleBindingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_bindings"
+}
+
+func (r *RoleBindingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "All role bindings of the RisingWave Cloud organization.",
+		MarkdownDescription: roleBindingsMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource, always `" + roleBindingsID + "`: the organization has one set of role bindings.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bindings": schema.SetNestedAttribute{
+				MarkdownDescription: "The role bindings of the organization, one per principal and role. This resource owns " +
+					"the whole set: a role bound anywhere else, in the RisingWave Cloud console for instance, is unbound " +
+					"on the next apply.",
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"principal": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the principal, i.e. a user or a service account.",
+							Required:            true,
+						},
+						"principal_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the principal, as the RisingWave Cloud API reports it. Import the " +
+								"resource to see the types of the existing principals.",
+							Required: true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the role bound to the principal.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleBindingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// desiredRoleBindings groups the configured bindings by principal.
+func desiredRoleBindings(ctx context.Context, set types.Set, diags *diag.Diagnostics) map[uuid.UUID]principalRoles {
+	var entries []RoleBindingsEntryModel
+	diags.Append(set.ElementsAs(ctx, &entries, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	rtn := map[uuid.UUID]principalRoles{}
+	for _, entry := range entries {
+		principal, err := uuid.Parse(entry.Principal.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("bindings"), "principal is invalid", fmt.Sprintf("Cannot parse principal %s", entry.Principal.String()))
+			continue
+		}
+		roleID, err := uuid.Parse(entry.RoleID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("bindings"), "role_id is invalid", fmt.Sprintf("Cannot parse role ID %s", entry.RoleID.String()))
+			continue
+		}
+		roles, ok := rtn[principal]
+		if !ok {
+			roles = principalRoles{principalType: entry.PrincipalType.ValueString(), roleIDs: map[uuid.UUID]bool{}}
+		}
+		if roles.principalType != entry.PrincipalType.ValueString() {
+			diags.AddAttributeError(
+				path.Root("bindings"),
+				"principal_type is inconsistent",
+				fmt.Sprintf("Principal %s is bound with the types %q and %q, a principal has one type", principal, roles.principalType, entry.PrincipalType.ValueString()),
+			)
+			continue
+		}
+		roles.roleIDs[roleID] = true
+		rtn[principal] = roles
+	}
+	return rtn
+}
+
+// currentRoleBindings groups the bindings of the platform by principal.
+func currentRoleBindings(bindings []apigen_accv2.RoleBinding) map[uuid.UUID]principalRoles {
+	rtn := map[uuid.UUID]principalRoles{}
+	for _, b := range bindings {
+		roles, ok := rtn[b.Principal]
+		if !ok {
+			roles = principalRoles{roleIDs: map[uuid.UUID]bool{}}
+			if b.PrincipalType != nil {
+				roles.principalType = *b.PrincipalType
+			}
+		}
+		roles.roleIDs[b.RoleId] = true
+		rtn[b.Principal] = roles
+	}
+	return rtn
+}
+
+// checkRoles fails if a desired role does not exist, before anything is applied: the bindings are
+// updated one principal at a time, so a typo caught halfway would leave a partial change behind.
+func (r *RoleBindingsResource) checkRoles(ctx context.Context, desired map[uuid.UUID]principalRoles) error {
+	roles, err := r.client.GetRoles(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the roles of the organization")
+	}
+	exists := map[uuid.UUID]bool{}
+	var available []string
+	for _, role := range roles {
+		exists[role.Id] = true
+		available = append(available, fmt.Sprintf("%s (%s)", role.Name, role.Id))
+	}
+	for _, roles := range desired {
+		for roleID := range roles.roleIDs {
+			if !exists[roleID] {
+				return errors.Errorf("role %s does not exist, the roles of the organization are: %s", roleID, strings.Join(available, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// applyRoleBindings brings the bindings of every principal to `desired`, one principal at a time.
+// A principal missing from `desired` loses all of its roles.
+//
+// The principals that only gain roles go first and those that lose some go last. Unbinding roles
+// first could take the organization admin role away from the principal terraform runs as, and
+// every remaining call would then be refused.
+func (r *RoleBindingsResource) applyRoleBindings(ctx context.Context, current, desired map[uuid.UUID]principalRoles) error {
+	var gaining, losing []uuid.UUID
+	for principal, roles := range desired {
+		if cur, ok := current[principal]; ok && roles.covers(cur) && cur.covers(roles) {
+			continue
+		}
+		if roles.covers(current[principal]) {
+			gaining = append(gaining, principal)
+		} else {
+			losing = append(losing, principal)
+		}
+	}
+	for principal := range current {
+		if _, ok := desired[principal]; !ok {
+			losing = append(losing, principal)
+		}
+	}
+	sort.Slice(gaining, func(i, j int) bool { return gaining[i].String() < gaining[j].String() })
+	sort.Slice(losing, func(i, j int) bool { return losing[i].String() < losing[j].String() })
+
+	for _, principal := range append(gaining, losing...) {
+		roles, ok := desired[principal]
+		if !ok {
+			roles = principalRoles{principalType: current[principal].principalType}
+		}
+		if err := r.client.UpdateRoleBindings(ctx, principal, roles.principalType, roles.sortedRoleIDs()); err != nil {
+			return errors.Wrapf(err, "failed to update the role bindings of %s", principal)
+		}
+	}
+	return nil
+}
+
+// roleBindingsValue converts the bindings of the platform to the `bindings` attribute.
+func roleBindingsValue(ctx context.Context, bindings []apigen_accv2.RoleBinding, diags *diag.Diagnostics) types.Set {
+	entries := make([]RoleBindingsEntryModel, 0, len(bindings))
+	for _, b := range bindings {
+		entry := RoleBindingsEntryModel{
+			Principal:     types.StringValue(b.Principal.String()),
+			PrincipalType: types.StringValue(""),
+			RoleID:        types.StringValue(b.RoleId.String()),
+		}
+		if b.PrincipalType != nil {
+			entry.PrincipalType = types.StringValue(*b.PrincipalType)
+		}
+		entries = append(entries, entry)
+	}
+	value, valueDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: roleBindingsEntryAttrTypes}, entries)
+	diags.Append(valueDiags...)
+	return value
+}
+
+// setStateFromPlatform records the bindings the platform actually has. It is called after a
+// successful change and after a failed one, since in both cases it is the only truthful answer.
+func (r *RoleBindingsResource) setStateFromPlatform(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics) {
+	bindings, err := r.client.GetRoleBindings(ctx, nil)
+	if err != nil {
+		diags.AddError("Unable to read the role bindings", err.Error())
+		return
+	}
+
+	value := roleBindingsValue(ctx, bindings, diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), roleBindingsID)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("bindings"), value)...)
+}
+
+// apply is shared by Create and Update: the platform is asked rather than trusting the state, so
+// that a role bound elsewhere is unbound as the authoritative set demands.
+func (r *RoleBindingsResource) apply(ctx context.Context, plan tfsdk.Plan, state *tfsdk.State, diags *diag.Diagnostics) {
+	var data RoleBindingsModel
+
+	diags.Append(plan.Get(ctx, &data)...)
+	if diags.HasError() {
+		return
+	}
+
+	desired := desiredRoleBindings(ctx, data.Bindings, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if err := r.checkRoles(ctx, desired); err != nil {
+		diags.AddError("Invalid role bindings", err.Error())
+		return
+	}
+
+	bindings, err := r.client.GetRoleBindings(ctx, nil)
+	if err != nil {
+		diags.AddError("Unable to read the role bindings", err.Error())
+		return
+	}
+
+	applyErr := r.applyRoleBindings(ctx, currentRoleBindings(bindings), desired)
+
+	// recorded even when the change failed halfway: some of it may have been applied
+	r.setStateFromPlatform(ctx, state, diags)
+	if applyErr != nil {
+		diags.AddError("Unable to update the role bindings", applyErr.Error())
+		return
+	}
+}
+
+func (r *RoleBindingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.apply(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "role bindings of the organization set")
+}
+
+func (r *RoleBindingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleBindingsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings, err := r.client.GetRoleBindings(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the role bindings", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(roleBindingsID)
+	data.Bindings = roleBindingsValue(ctx, bindings, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.apply(ctx, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete leaves the bindings as they are. Unbinding every role of the organization would lock
+// everyone out of it, the principal terraform runs as included.
+func (r *RoleBindingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "role bindings of the organization removed from the state, the bindings themselves are kept")
+}
+
+func (r *RoleBindingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != roleBindingsID {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected %s, the organization has one set of role bindings, got: %s", roleBindingsID, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleBindingsID)...)
+}
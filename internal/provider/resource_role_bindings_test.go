@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	roleAdmin  = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	roleViewer = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+)
+
+// fakeRoleBindingsClient records the updates a change makes.
+type fakeRoleBindingsClient struct {
+	cloudsdk.CloudClientInterface
+
+	current []apigen_accv2.RoleBinding
+	calls   []string
+}
+
+func (c *fakeRoleBindingsClient) GetRoles(ctx context.Context) ([]apigen_accv2.Role, error) {
+	return []apigen_accv2.Role{
+		{Id: roleAdmin, Name: "OrganizationAdmin"},
+		{Id: roleViewer, Name: "ProjectViewer"},
+	}, nil
+}
+
+func (c *fakeRoleBindingsClient) GetRoleBindings(ctx context.Context, principal *uuid.UUID) ([]apigen_accv2.RoleBinding, error) {
+	return c.current, nil
+}
+
+func (c *fakeRoleBindingsClient) UpdateRoleBindings(ctx context.Context, principal uuid.UUID, principalType string, roleIDs []uuid.UUID) error {
+	c.calls = append(c.calls, fmt.Sprintf("%s %s %v", principalType, principal, roleIDs))
+	return nil
+}
+
+func roleBinding(principal uuid.UUID, roleID uuid.UUID) apigen_accv2.RoleBinding {
+	principalType := "ServiceAccount"
+	return apigen_accv2.RoleBinding{Principal: principal, PrincipalType: &principalType, RoleId: roleID}
+}
+
+func roleBindingsPlan(ctx context.Context, t *testing.T, schemaResp resource.SchemaResponse, bindings []apigen_accv2.RoleBinding) tfsdk.Plan {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags.Append(plan.Set(ctx, &RoleBindingsModel{
+		ID:       types.StringUnknown(),
+		Bindings: roleBindingsValue(ctx, bindings, &diags),
+	})...)
+	require.False(t, diags.HasError(), diags.Errors())
+	return plan
+}
+
+// The principals that only gain roles are updated first: unbinding first could take the admin
+// role away from the principal terraform runs as and fail every remaining update.
+func TestApplyRoleBindingsGainsBeforeLosses(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		losing    = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+		removed   = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+		gaining   = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+		unchanged = uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+	)
+
+	client := &fakeRoleBindingsClient{current: []apigen_accv2.RoleBinding{
+		roleBinding(losing, roleAdmin),
+		roleBinding(losing, roleViewer),
+		roleBinding(removed, roleViewer),
+		roleBinding(gaining, roleViewer),
+		roleBinding(unchanged, roleAdmin),
+	}}
+	r := &RoleBindingsResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	plan := roleBindingsPlan(ctx, t, schemaResp, []apigen_accv2.RoleBinding{
+		roleBinding(losing, roleViewer),
+		roleBinding(gaining, roleAdmin),
+		roleBinding(gaining, roleViewer),
+		roleBinding(unchanged, roleAdmin),
+	})
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	assert.Equal(t, []string{
+		fmt.Sprintf("ServiceAccount %s %v", gaining, []uuid.UUID{roleAdmin, roleViewer}),
+		fmt.Sprintf("ServiceAccount %s %v", losing, []uuid.UUID{roleViewer}),
+		fmt.Sprintf("ServiceAccount %s %v", removed, []uuid.UUID{}),
+	}, client.calls)
+}
+
+// A role that does not exist fails the apply before any principal is updated.
+func TestApplyRoleBindingsUnknownRole(t *testing.T) {
+	ctx := context.Background()
+	principal := uuid.Must(uuid.NewRandom())
+
+	client := &fakeRoleBindingsClient{}
+	r := &RoleBindingsResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	plan := roleBindingsPlan(ctx, t, schemaResp, []apigen_accv2.RoleBinding{
+		roleBinding(principal, roleAdmin),
+		roleBinding(principal, uuid.Must(uuid.NewRandom())),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "OrganizationAdmin ("+roleAdmin.String()+")")
+	assert.Empty(t, client.calls)
+}
+
+func TestDesiredRoleBindingsInconsistentPrincipalType(t *testing.T) {
+	ctx := context.Background()
+	principal := uuid.Must(uuid.NewRandom())
+
+	user := "User"
+	bindings := []apigen_accv2.RoleBinding{
+		roleBinding(principal, roleAdmin),
+		{Principal: principal, PrincipalType: &user, RoleId: roleViewer},
+	}
+
+	var diags diag.Diagnostics
+	desiredRoleBindings(ctx, roleBindingsValue(ctx, bindings, &diags), &diags)
+	assert.True(t, diags.HasError())
+}
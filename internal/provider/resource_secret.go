@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretResource{}
+var _ resource.ResourceWithImportState = &SecretResource{}
+
+func NewSecretResource() resource.Resource {
+	return &SecretResource{}
+}
+
+// SecretResource manages a secret in a database of a cluster. The value is write-only, so it
+// never reaches the plan or the state. The API cannot update a secret, a new value is applied
+// with ALTER SECRET instead.
+type SecretResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type SecretModel struct {
+	// [cluster ID].[database name].[secret name]
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Database  types.String `tfsdk:"database"`
+	Name      types.String `tfsdk:"name"`
+	// ValueWO is always null here, see ClusterUserModel.PasswordWO. The value is read from the
+	// configuration in Create and Update. Never assign to it.
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	Schema         types.String `tfsdk:"schema"`
+	Owner          types.String `tfsdk:"owner"`
+}
+
+func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A secret in a database of a RisingWave cluster, e.g. the credentials of a Kafka or Postgres connector.",
+		MarkdownDescription: secretMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The global identifier for the resource: [cluster ID].[database name].[secret name]",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database the secret is created in, e.g. the `name` of a " +
+					"`risingwavecloud_database` resource.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret, unique within the database. Secret names cannot contain dots.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "The value of the secret, as a " +
+					"[write-only argument](https://developer.hashicorp.com/terraform/language/manage-sensitive-data/write-only): " +
+					"Terraform sends it to the provider but stores it in neither the plan nor the state. Requires Terraform " +
+					"1.11 or later.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `value_wo`. Terraform cannot detect a change in a value it does not " +
+					"store, so increment this whenever `value_wo` changes to have the new value applied. The secret is " +
+					"updated in place, so the sources, sinks and connections using it keep running.",
+				Required: true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The schema the secret is created in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The database user owning the secret.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func secretToDataModel(clusterNsID uuid.UUID, database string, secret *apigen_mgmtv2.Secret, data *SecretModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", clusterNsID.String(), database, secret.Name))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.Database = types.StringValue(database)
+	data.Name = types.StringValue(secret.Name)
+	data.Schema = types.StringValue(secret.Schema)
+	data.Owner = types.StringValue(secret.Owner)
+}
+
+// parseSecretIdentifier splits `[cluster ID].[database name].[secret name]`. A cluster ID has no
+// dots and neither has a secret name, so the database name may contain dots like in
+// parseDatabaseIdentifier.
+func parseSecretIdentifier(secretResourceID string, diags *diag.Diagnostics) (nsID uuid.UUID, database, name string) {
+	clusterID, rest, ok := strings.Cut(secretResourceID, ".")
+	idx := strings.LastIndex(rest, ".")
+	if !ok || idx <= 0 || idx == len(rest)-1 {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot parse secret ID: %s", secretResourceID))
+		return
+	}
+	var err error
+	nsID, err = uuid.Parse(clusterID)
+	if err != nil {
+		diags.AddError("Invalid ID", fmt.Sprintf("Cannot extract cluster ID from secret ID: %s", secretResourceID))
+		return
+	}
+	database, name = rest[:idx], rest[idx+1:]
+	return
+}
+
+// formatDependencies lists the objects depending on another one for a diagnostic.
+func formatDependencies(references []apigen_mgmtv2.RwDependency) string {
+	lines := make([]string, 0, len(references))
+	for _, ref := range references {
+		lines = append(lines, fmt.Sprintf("  - %s %s.%s", strings.ToLower(ref.Type), ref.Schema, ref.Name))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecretModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		database = data.Database.ValueString()
+		name     = data.Name.ValueString()
+	)
+
+	if strings.Contains(name, ".") {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid secret name", fmt.Sprintf("The secret name %s cannot contain dots", name))
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	// the write-only value never reaches the plan, only the configuration holds it.
+	value := readWriteOnlyValue(ctx, req.Config, path.Root("value_wo"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(value) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("value_wo"), "Value is required", "The value of a secret cannot be empty")
+		return
+	}
+
+	if err := r.client.CreateSecret(ctx, nsID, database, name, value); err != nil {
+		resp.Diagnostics.AddError("Unable to create secret", err.Error())
+		return
+	}
+
+	secret, err := r.client.GetSecret(ctx, nsID, database, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read secret", err.Error())
+		return
+	}
+
+	secretToDataModel(nsID, database, secret, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("secret created, name: %s", name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, database, name := parseSecretIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.GetSecret(ctx, nsID, database, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrSecretNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("secret %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read secret", err.Error())
+		return
+	}
+
+	// the value version is kept from the state, the value itself is never read back.
+	secretToDataModel(nsID, database, secret, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the value of a new value_wo_version. The first version recorded for an imported
+// secret is not applied, the secret keeps the value it has.
+func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data  SecretModel
+		state SecretModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ValueWOVersion.IsNull() && !data.ValueWOVersion.Equal(state.ValueWOVersion) {
+		nsID, database, name := parseSecretIdentifier(state.ID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		value := readWriteOnlyValue(ctx, req.Config, path.Root("value_wo"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(value) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("value_wo"), "Value is required", "The value of a secret cannot be empty")
+			return
+		}
+
+		// secrets created through the API are stored in the meta backend. The statement holds the
+		// value, so unlike addBatchSQLDiagnostics the diagnostics never echo it.
+		statement := fmt.Sprintf(
+			"ALTER SECRET %s.%s WITH (backend = 'meta') AS %s",
+			quoteIdentifier(state.Schema.ValueString()), quoteIdentifier(name), quoteLiteral(value),
+		)
+		results, err := r.client.ExecuteSQLBatch(ctx, nsID, database, []string{statement})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to update secret", err.Error())
+			return
+		}
+		if len(results) == 0 {
+			resp.Diagnostics.AddError("Unable to update secret", "The statement updating the secret was not run")
+			return
+		}
+		if results[0].Error != nil {
+			resp.Diagnostics.AddError("Unable to update secret", *results[0].Error)
+			return
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("secret updated, name: %s", name))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete refuses to drop a secret that is still in use. This is checked here rather than while
+// planning: terraform destroys the sources and sinks depending on the secret before the secret
+// itself, so they are only gone by the time the secret is deleted.
+func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, database, name := parseSecretIdentifier(data.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	references, err := r.client.GetSecretReferences(ctx, nsID, database, name)
+	if err != nil {
+		// the secret goes away with its database or cluster.
+		if errors.Is(err, cloudsdk.ErrSecretNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("secret %s not found, it is already gone", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read secret references", err.Error())
+		return
+	}
+	if len(references) > 0 {
+		resp.Diagnostics.AddError(
+			"Secret is in use",
+			fmt.Sprintf(
+				"The secret %s in database %s is used by the objects below. Drop them, or remove them from the "+
+					"configuration, before deleting or replacing the secret.\n\n%s",
+				name, database, formatDependencies(references),
+			),
+		)
+		return
+	}
+
+	if err := r.client.DeleteSecret(ctx, nsID, database, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete secret", err.Error())
+		return
+	}
+}
+
+func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, database, name := parseSecretIdentifier(req.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.GetSecret(ctx, nsID, database, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import secret with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
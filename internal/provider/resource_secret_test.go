@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretIdentifier(t *testing.T) {
+	nsID := uuid.Must(uuid.NewRandom())
+
+	var diags diag.Diagnostics
+	parsedNsID, database, name := parseSecretIdentifier(nsID.String()+".sales.eu.kafka_password", &diags)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, nsID, parsedNsID)
+	assert.Equal(t, "sales.eu", database)
+	assert.Equal(t, "kafka_password", name)
+
+	for _, id := range []string{nsID.String() + ".dev", nsID.String() + ".dev.", nsID.String() + "..kafka_password", "not-a-uuid.dev.kafka_password"} {
+		var diags diag.Diagnostics
+		parseSecretIdentifier(id, &diags)
+		assert.True(t, diags.HasError(), "id: %s", id)
+	}
+}
+
+func TestSecretDelete(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		expect      func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError bool
+	}{
+		{
+			name: "unused secret",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSecretReferences(gomock.Any(), nsID, "dev", "kafka_password").Return(nil, nil)
+				client.EXPECT().DeleteSecret(gomock.Any(), nsID, "dev", "kafka_password").Return(nil)
+			},
+		},
+		{
+			name: "secret in use",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSecretReferences(gomock.Any(), nsID, "dev", "kafka_password").Return([]apigen_mgmtv2.RwDependency{
+					{Name: "orders", Schema: "public", Type: "SOURCE"},
+				}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "secret already gone",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSecretReferences(gomock.Any(), nsID, "dev", "kafka_password").
+					Return(nil, errors.Wrap(cloudsdk.ErrSecretNotFound, "kafka_password"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &SecretResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":               tftypes.NewValue(tftypes.String, nsID.String()+".dev.kafka_password"),
+				"cluster_id":       tftypes.NewValue(tftypes.String, nsID.String()),
+				"database":         tftypes.NewValue(tftypes.String, "dev"),
+				"name":             tftypes.NewValue(tftypes.String, "kafka_password"),
+				"value_wo":         tftypes.NewValue(tftypes.String, nil),
+				"value_wo_version": tftypes.NewValue(tftypes.Number, 1),
+				"schema":           tftypes.NewValue(tftypes.String, "public"),
+				"owner":            tftypes.NewValue(tftypes.String, "root"),
+			})
+			resp := &resource.DeleteResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			r.Delete(ctx, resource.DeleteRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			if tt.expectError {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "source public.orders")
+			}
+		})
+	}
+}
+
+func TestSecretUpdate(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+	statement := `ALTER SECRET "public"."kafka_password" WITH (backend = 'meta') AS 'it''s new'`
+
+	tests := []struct {
+		name         string
+		stateVersion interface{}
+		expect       func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError  bool
+	}{
+		{
+			name:         "new version",
+			stateVersion: 1,
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().ExecuteSQLBatch(gomock.Any(), nsID, "dev", []string{statement}).
+					Return([]apigen_mgmtv2.BatchQueryResult{{Query: statement}}, nil)
+			},
+		},
+		{
+			name:         "first version of an imported secret",
+			stateVersion: nil,
+			expect:       func(client *cloudsdk_mock.MockCloudClientInterface) {},
+		},
+		{
+			name:         "statement failed",
+			stateVersion: 1,
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().ExecuteSQLBatch(gomock.Any(), nsID, "dev", []string{statement}).
+					Return([]apigen_mgmtv2.BatchQueryResult{{Query: statement, Error: ptr.Ptr("permission denied")}}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &SecretResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			secret := func(value, version interface{}) tftypes.Value {
+				return tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":               tftypes.NewValue(tftypes.String, nsID.String()+".dev.kafka_password"),
+					"cluster_id":       tftypes.NewValue(tftypes.String, nsID.String()),
+					"database":         tftypes.NewValue(tftypes.String, "dev"),
+					"name":             tftypes.NewValue(tftypes.String, "kafka_password"),
+					"value_wo":         tftypes.NewValue(tftypes.String, value),
+					"value_wo_version": tftypes.NewValue(tftypes.Number, version),
+					"schema":           tftypes.NewValue(tftypes.String, "public"),
+					"owner":            tftypes.NewValue(tftypes.String, "root"),
+				})
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: secret(nil, tt.stateVersion)}
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: secret("it's new", 2)},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: secret(nil, 2)},
+				State:  state,
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			if tt.expectError {
+				// the statement holds the value of the secret, it is never shown.
+				assert.NotContains(t, resp.Diagnostics.Errors()[0].Detail(), "it''s new")
+				return
+			}
+
+			var data SecretModel
+			require.False(t, resp.State.Get(ctx, &data).HasError())
+			assert.Equal(t, int64(2), data.ValueWOVersion.ValueInt64())
+			assert.True(t, data.ValueWO.IsNull())
+		})
+	}
+}
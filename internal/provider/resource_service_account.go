@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceAccountResource{}
+var _ resource.ResourceWithImportState = &ServiceAccountResource{}
+
+func NewServiceAccountResource() resource.Resource {
+	return &ServiceAccountResource{}
+}
+
+type ServiceAccountResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type ServiceAccountModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	RoleID      types.String `tfsdk:"role_id"`
+	OrgID       types.String `tfsdk:"org_id"`
+	APIKeyCount types.Int64  `tfsdk:"api_key_count"`
+	Roles       types.List   `tfsdk:"roles"`
+}
+
+func (r *ServiceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *ServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A service account of the RisingWave Cloud organization, i.e. a principal for API keys.",
+		MarkdownDescription: serviceAccountMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service account. Changing it replaces the service account.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the service account.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the role the service account is created with. Changing it replaces " +
+					"the service account. The role is not read back, so roles bound to the service account later on, " +
+					"e.g. in the console, only show up in `roles`.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfRoleIDChanged,
+						"Changing the role replaces the service account, setting it after an import does not.",
+						"Changing the role replaces the service account, setting it after an import does not.",
+					),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization of the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of API keys of the service account.",
+				Computed:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The names of the roles bound to the service account.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// requiresReplaceIfRoleIDChanged does not replace an imported service account, whose role_id is
+// unknown until the configuration sets it.
+func requiresReplaceIfRoleIDChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func serviceAccountToDataModel(ctx context.Context, sa *apigen_accv2.ServiceAccount, data *ServiceAccountModel) error {
+	data.ID = types.StringValue(sa.Id.String())
+	data.Name = types.StringValue(sa.Name)
+	data.Description = types.StringValue(sa.Description)
+	data.OrgID = types.StringValue(sa.OrgId.String())
+	data.APIKeyCount = types.Int64Value(int64(sa.ApiKeyCount))
+	roles, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, sa.Roles...))
+	if diags.HasError() {
+		return errors.Errorf("failed to convert the roles of the service account: %v", diags.Errors())
+	}
+	data.Roles = roles
+	return nil
+}
+
+func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceAccountModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID, err := uuid.Parse(data.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "role_id is invalid", fmt.Sprintf("Cannot parse role ID %s", data.RoleID.String()))
+		return
+	}
+
+	sa, err := r.client.CreateServiceAccount(ctx, data.Name.ValueString(), data.Description.ValueString(), roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create service account", err.Error())
+		return
+	}
+
+	if err := serviceAccountToDataModel(ctx, sa, &data); err != nil {
+		resp.Diagnostics.AddError("Unable to read service account", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("service account created, ID: %s", sa.Id.String()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ID is invalid", fmt.Sprintf("Cannot parse service account ID %s", data.ID.String()))
+		return
+	}
+
+	sa, err := r.client.GetServiceAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrServiceAccountNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("service account %s not found, removing it from the state", id.String()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read service account", err.Error())
+		return
+	}
+
+	if err := serviceAccountToDataModel(ctx, sa, &data); err != nil {
+		resp.Diagnostics.AddError("Unable to read service account", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var (
+		data  ServiceAccountModel
+		state ServiceAccountModel
+	)
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ID is invalid", fmt.Sprintf("Cannot parse service account ID %s", state.ID.String()))
+		return
+	}
+
+	// the name and the role require a replacement, only the description can be updated.
+	if !data.Description.Equal(state.Description) {
+		if err := r.client.UpdateServiceAccountDescription(ctx, id, data.Description.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Unable to update service account", err.Error())
+			return
+		}
+	}
+
+	sa, err := r.client.GetServiceAccount(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read service account", err.Error())
+		return
+	}
+
+	if err := serviceAccountToDataModel(ctx, sa, &data); err != nil {
+		resp.Diagnostics.AddError("Unable to read service account", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("ID is invalid", fmt.Sprintf("Cannot parse service account ID %s", data.ID.String()))
+		return
+	}
+
+	if err := r.client.DeleteServiceAccount(ctx, id); err != nil {
+		resp.Diagnostics.AddError("Unable to delete service account", err.Error())
+		return
+	}
+}
+
+func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Expected the UUID of the service account, got: %s", req.ID))
+		return
+	}
+
+	if _, err := r.client.GetServiceAccount(ctx, id); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import service account with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+}
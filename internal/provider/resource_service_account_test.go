@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_accv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/acc/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serviceAccountRaw(ctx context.Context, s resource.SchemaResponse, values map[string]tftypes.Value) tftypes.Value {
+	objectType := s.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func TestServiceAccountCreateAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+
+	var (
+		id     = uuid.Must(uuid.NewRandom())
+		orgID  = uuid.Must(uuid.NewRandom())
+		roleID = uuid.Must(uuid.NewRandom())
+		now    = time.Now()
+	)
+	sa := &apigen_accv2.ServiceAccount{
+		Id:          id,
+		OrgId:       orgID,
+		Name:        "pipeline-orders",
+		Description: "orders pipeline",
+		Roles:       []string{"ProjectAdmin"},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	client.EXPECT().CreateServiceAccount(gomock.Any(), "pipeline-orders", "orders pipeline", roleID).Return(sa, nil)
+
+	r := &ServiceAccountResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	plan := serviceAccountRaw(ctx, schemaResp, map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "pipeline-orders"),
+		"description": tftypes.NewValue(tftypes.String, "orders pipeline"),
+		"role_id":     tftypes.NewValue(tftypes.String, roleID.String()),
+	})
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics.Errors())
+
+	var data ServiceAccountModel
+	require.False(t, createResp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, ServiceAccountModel{
+		ID:          types.StringValue(id.String()),
+		Name:        types.StringValue("pipeline-orders"),
+		Description: types.StringValue("orders pipeline"),
+		RoleID:      types.StringValue(roleID.String()),
+		OrgID:       types.StringValue(orgID.String()),
+		APIKeyCount: types.Int64Value(0),
+		Roles:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ProjectAdmin")}),
+	}, data)
+
+	// only the description is sent on update.
+	updated := *sa
+	updated.Description = "orders and payments pipeline"
+	updated.ApiKeyCount = 1
+	client.EXPECT().UpdateServiceAccountDescription(gomock.Any(), id, "orders and payments pipeline").Return(nil)
+	client.EXPECT().GetServiceAccount(gomock.Any(), id).Return(&updated, nil)
+
+	plan = serviceAccountRaw(ctx, schemaResp, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, id.String()),
+		"name":        tftypes.NewValue(tftypes.String, "pipeline-orders"),
+		"description": tftypes.NewValue(tftypes.String, "orders and payments pipeline"),
+		"role_id":     tftypes.NewValue(tftypes.String, roleID.String()),
+		"org_id":      tftypes.NewValue(tftypes.String, orgID.String()),
+	})
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+		State: createResp.State,
+	}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics.Errors())
+
+	require.False(t, updateResp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "orders and payments pipeline", data.Description.ValueString())
+	assert.Equal(t, int64(1), data.APIKeyCount.ValueInt64())
+	assert.Equal(t, roleID.String(), data.RoleID.ValueString())
+}
+
+func TestServiceAccountReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	id := uuid.Must(uuid.NewRandom())
+
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	client.EXPECT().GetServiceAccount(gomock.Any(), id).Return(nil, errors.Wrap(cloudsdk.ErrServiceAccountNotFound, "not found"))
+
+	r := &ServiceAccountResource{client: client}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: serviceAccountRaw(ctx, schemaResp, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, id.String()),
+	})}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	assert.True(t, resp.State.Raw.IsNull())
+}
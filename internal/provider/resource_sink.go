@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SinkResource{}
+var _ resource.ResourceWithImportState = &SinkResource{}
+var _ resource.ResourceWithValidateConfig = &SinkResource{}
+
+func NewSinkResource() resource.Resource {
+	return &SinkResource{}
+}
+
+// SinkResource manages a sink, created in SQL from its upstream, its connector and its
+// properties. Like a source, a sink cannot be altered, so every change replaces it.
+type SinkResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type SinkModel struct {
+	// [cluster ID].[database name].[schema].[name]
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Database  types.String `tfsdk:"database"`
+	Schema    types.String `tfsdk:"schema"`
+	Name      types.String `tfsdk:"name"`
+	From      types.String `tfsdk:"from"`
+	Owner     types.String `tfsdk:"owner"`
+	ConnectorModel
+}
+
+func (r *SinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sink"
+}
+
+func (r *SinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The global identifier for the resource: [cluster ID].[database name].[schema].[name]",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": schema.StringAttribute{
+			MarkdownDescription: "The NsID (namespace id) of the cluster.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"database": schema.StringAttribute{
+			MarkdownDescription: "The name of the database the sink is created in, e.g. the `name` of a " +
+				"`risingwavecloud_database` resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"schema": schema.StringAttribute{
+			MarkdownDescription: "The schema the sink is created in. Defaults to `" + defaultSchema + "`, " +
+				"which is the only schema supported.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(defaultSchema),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				defaultSchemaValidator{objects: "Sinks"},
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the sink. It is quoted, so it is case-sensitive.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"from": schema.StringAttribute{
+			MarkdownDescription: "The table, source or materialized view the sink reads from, e.g. `public.orders`. " +
+				"It is written into the statement as it is, so quote case-sensitive names.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "The database user owning the sink.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	for name, attribute := range connectorSchemaAttributes("sink") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "A sink in a database of a RisingWave cluster, delivering data to a downstream system.",
+		MarkdownDescription: sinkMarkdownDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *SinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SinkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateConnectorConfig(ctx, data.ConnectorModel, &resp.Diagnostics)
+}
+
+func sinkToDataModel(clusterNsID uuid.UUID, database string, sink *apigen_mgmtv2.SinkInfo, data *SinkModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s.%s", clusterNsID.String(), database, sink.Schema, sink.SinkName))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.Database = types.StringValue(database)
+	data.Schema = types.StringValue(sink.Schema)
+	data.Name = types.StringValue(sink.SinkName)
+	data.Owner = types.StringValue(sink.Owner)
+
+	var properties map[string]string
+	if sink.Properties != nil {
+		properties = *sink.Properties
+	}
+	connectorToDataModel(sink.ConnectorType, properties, &data.ConnectorModel)
+}
+
+func (r *SinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		database = data.Database.ValueString()
+		schema   = data.Schema.ValueString()
+		name     = data.Name.ValueString()
+	)
+
+	if strings.Contains(schema, ".") || strings.Contains(name, ".") {
+		resp.Diagnostics.AddError("Invalid name", "The schema and the name of a sink cannot contain dots")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	statement := fmt.Sprintf(
+		"CREATE SINK %s.%s FROM %s %s",
+		quoteIdentifier(schema), quoteIdentifier(name), data.From.ValueString(), connectorClauses(ctx, data.ConnectorModel, &resp.Diagnostics),
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statements := []string{statement}
+	results, err := r.client.ExecuteSQLBatch(ctx, nsID, database, statements)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create sink", err.Error())
+		return
+	}
+	if addBatchSQLDiagnostics(statements, results, "Unable to create sink", &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
+	sink, err := r.client.GetSink(ctx, nsID, database, schema, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read sink", err.Error())
+		return
+	}
+
+	sinkToDataModel(nsID, database, sink, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("sink created, name: %s.%s", schema, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "sink", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sink, err := r.client.GetSink(ctx, nsID, database, schema, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrSinkNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("sink %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read sink", err.Error())
+		return
+	}
+
+	sinkToDataModel(nsID, database, sink, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a change, every configurable attribute requires a replacement.
+func (r *SinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SinkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete refuses to drop a sink that others depend on. It is checked here rather than while
+// planning, since terraform destroys the objects depending on it first.
+func (r *SinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SinkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "sink", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// a sink created in another schema before the schema was restricted: dropping it by name
+	// could drop the sink of the same name in the default schema instead.
+	if schema != defaultSchema {
+		resp.Diagnostics.AddError(
+			"Unable to delete sink",
+			unsupportedSchemaMessage("Sinks", schema)+fmt.Sprintf(
+				". Drop the sink %s.%s in SQL and remove it from the state with `terraform state rm`.", schema, name),
+		)
+		return
+	}
+
+	downstreams, err := r.client.GetSinkDownstreams(ctx, nsID, database, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrSinkNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("sink %s not found, it is already gone", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read sink dependencies", err.Error())
+		return
+	}
+	if len(downstreams) > 0 {
+		resp.Diagnostics.AddError(
+			"Sink is in use",
+			fmt.Sprintf(
+				"The sink %s.%s in database %s is depended on by the objects below. Drop them, or remove them from "+
+					"the configuration, before deleting or replacing the sink.\n\n%s",
+				schema, name, database, formatDependencies(downstreams),
+			),
+		)
+		return
+	}
+
+	if err := r.client.DeleteSink(ctx, nsID, database, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete sink", err.Error())
+		return
+	}
+}
+
+func (r *SinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, database, schema, name := parseSchemaObjectIdentifier(req.ID, "sink", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if schema != defaultSchema {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to import sink with ID: %s", req.ID),
+			unsupportedSchemaMessage("Sinks", schema),
+		)
+		return
+	}
+
+	if _, err := r.client.GetSink(ctx, nsID, database, schema, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import sink with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
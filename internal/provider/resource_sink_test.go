@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinkCreate(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	ctrl := gomock.NewController(t)
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+
+	statements := []string{
+		`CREATE SINK "public"."revenue_to_kafka" FROM revenue WITH (connector = 'kafka', topic = 'revenue') ` +
+			`FORMAT PLAIN ENCODE JSON (force_append_only = 'true')`,
+	}
+	gomock.InOrder(
+		client.EXPECT().ExecuteSQLBatch(gomock.Any(), nsID, "dev", statements).Return([]apigen_mgmtv2.BatchQueryResult{
+			{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+		}, nil),
+		client.EXPECT().GetSink(gomock.Any(), nsID, "dev", "public", "revenue_to_kafka").Return(&apigen_mgmtv2.SinkInfo{
+			Schema:        "public",
+			SinkName:      "revenue_to_kafka",
+			Owner:         "root",
+			ConnectorType: "kafka",
+			// the topic was changed outside of terraform.
+			Properties: &map[string]string{"connector": "kafka", "topic": "revenue_v2"},
+		}, nil),
+	)
+
+	r := &SinkResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	stringMapType := tftypes.Map{ElementType: tftypes.String}
+	plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+		"database":   tftypes.NewValue(tftypes.String, "dev"),
+		"schema":     tftypes.NewValue(tftypes.String, "public"),
+		"name":       tftypes.NewValue(tftypes.String, "revenue_to_kafka"),
+		"from":       tftypes.NewValue(tftypes.String, "revenue"),
+		"owner":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"connector":  tftypes.NewValue(tftypes.String, "kafka"),
+		"properties": tftypes.NewValue(stringMapType, map[string]tftypes.Value{
+			"topic": tftypes.NewValue(tftypes.String, "revenue"),
+		}),
+		"sensitive_properties": tftypes.NewValue(stringMapType, nil),
+		"secret_properties":    tftypes.NewValue(stringMapType, nil),
+		"format":               tftypes.NewValue(tftypes.String, "PLAIN"),
+		"encode":               tftypes.NewValue(tftypes.String, "JSON"),
+		"encode_properties": tftypes.NewValue(stringMapType, map[string]tftypes.Value{
+			"force_append_only": tftypes.NewValue(tftypes.String, "true"),
+		}),
+	})
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var data SinkModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, nsID.String()+".dev.public.revenue_to_kafka", data.ID.ValueString())
+	assert.Equal(t, stringMap(map[string]string{"topic": "revenue_v2"}), data.Properties)
+}
+
+// The downstreams and the drop address a sink by name alone, so a sink outside the default schema
+// is rejected rather than risking an action on `public.revenue_to_kafka`.
+func TestSinkOnlyInDefaultSchema(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	ctrl := gomock.NewController(t)
+	// no call is expected: any would act on the sink of the same name in the default schema.
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	r := &SinkResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	schemaAttribute, ok := schemaResp.Schema.Attributes["schema"].(schema.StringAttribute)
+	require.True(t, ok)
+	for value, expectErr := range map[string]bool{defaultSchema: false, "staging": true} {
+		validateResp := &validator.StringResponse{}
+		for _, v := range schemaAttribute.Validators {
+			v.ValidateString(ctx, validator.StringRequest{Path: path.Root("schema"), ConfigValue: types.StringValue(value)}, validateResp)
+		}
+		assert.Equal(t, expectErr, validateResp.Diagnostics.HasError(), "value: %s", value)
+	}
+
+	importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: nsID.String() + ".dev.staging.revenue_to_kafka"}, importResp)
+	assert.True(t, importResp.Diagnostics.HasError())
+
+	stringMapType := tftypes.Map{ElementType: tftypes.String}
+	state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                   tftypes.NewValue(tftypes.String, nsID.String()+".dev.staging.revenue_to_kafka"),
+		"cluster_id":           tftypes.NewValue(tftypes.String, nsID.String()),
+		"database":             tftypes.NewValue(tftypes.String, "dev"),
+		"schema":               tftypes.NewValue(tftypes.String, "staging"),
+		"name":                 tftypes.NewValue(tftypes.String, "revenue_to_kafka"),
+		"from":                 tftypes.NewValue(tftypes.String, "revenue"),
+		"owner":                tftypes.NewValue(tftypes.String, "root"),
+		"connector":            tftypes.NewValue(tftypes.String, "kafka"),
+		"properties":           tftypes.NewValue(stringMapType, nil),
+		"sensitive_properties": tftypes.NewValue(stringMapType, nil),
+		"secret_properties":    tftypes.NewValue(stringMapType, nil),
+		"format":               tftypes.NewValue(tftypes.String, "PLAIN"),
+		"encode":               tftypes.NewValue(tftypes.String, "JSON"),
+		"encode_properties":    tftypes.NewValue(stringMapType, nil),
+	})
+	deleteResp := &resource.DeleteResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state}}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state}}, deleteResp)
+	require.True(t, deleteResp.Diagnostics.HasError())
+	assert.Contains(t, deleteResp.Diagnostics.Errors()[0].Detail(), "terraform state rm")
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SourceResource{}
+var _ resource.ResourceWithImportState = &SourceResource{}
+var _ resource.ResourceWithValidateConfig = &SourceResource{}
+
+func NewSourceResource() resource.Resource {
+	return &SourceResource{}
+}
+
+// SourceResource manages a source, created in SQL from its connector and properties. A source
+// cannot be altered, so every change replaces it.
+type SourceResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type SourceModel struct {
+	// [cluster ID].[database name].[schema].[name]
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Database  types.String `tfsdk:"database"`
+	Schema    types.String `tfsdk:"schema"`
+	Name      types.String `tfsdk:"name"`
+	Columns   types.String `tfsdk:"columns"`
+	Owner     types.String `tfsdk:"owner"`
+	ConnectorModel
+}
+
+func (r *SourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source"
+}
+
+func (r *SourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The global identifier for the resource: [cluster ID].[database name].[schema].[name]",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_id": schema.StringAttribute{
+			MarkdownDescription: "The NsID (namespace id) of the cluster.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"database": schema.StringAttribute{
+			MarkdownDescription: "The name of the database the source is created in, e.g. the `name` of a " +
+				"`risingwavecloud_database` resource.",
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"schema": schema.StringAttribute{
+			MarkdownDescription: "The schema the source is created in. Defaults to `" + defaultSchema + "`, " +
+				"which is the only schema supported.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(defaultSchema),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				defaultSchemaValidator{objects: "Sources"},
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the source. It is quoted, so it is case-sensitive.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"columns": schema.StringAttribute{
+			MarkdownDescription: "The column definitions of the source as in SQL, e.g. `id BIGINT, payload JSONB`. Not " +
+				"needed if the columns are inferred from a schema registry or from the upstream database.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"owner": schema.StringAttribute{
+			MarkdownDescription: "The database user owning the source.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	for name, attribute := range connectorSchemaAttributes("source") {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "A source in a database of a RisingWave cluster, ingesting data from an upstream system.",
+		MarkdownDescription: sourceMarkdownDescription,
+		Attributes:          attributes,
+	}
+}
+
+func (r *SourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateConnectorConfig(ctx, data.ConnectorModel, &resp.Diagnostics)
+}
+
+func sourceToDataModel(clusterNsID uuid.UUID, database string, source *apigen_mgmtv2.SourceInfo, data *SourceModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s.%s", clusterNsID.String(), database, source.Schema, source.SourceName))
+	data.ClusterID = types.StringValue(clusterNsID.String())
+	data.Database = types.StringValue(database)
+	data.Schema = types.StringValue(source.Schema)
+	data.Name = types.StringValue(source.SourceName)
+	data.Owner = types.StringValue(source.Owner)
+
+	var connectorType string
+	if source.ConnectorType != nil {
+		connectorType = *source.ConnectorType
+	}
+	connectorToDataModel(connectorType, source.Properties, &data.ConnectorModel)
+}
+
+func (r *SourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		database = data.Database.ValueString()
+		schema   = data.Schema.ValueString()
+		name     = data.Name.ValueString()
+	)
+
+	if strings.Contains(schema, ".") || strings.Contains(name, ".") {
+		resp.Diagnostics.AddError("Invalid name", "The schema and the name of a source cannot contain dots")
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	statement := fmt.Sprintf("CREATE SOURCE %s.%s", quoteIdentifier(schema), quoteIdentifier(name))
+	if !data.Columns.IsNull() {
+		statement += fmt.Sprintf(" (%s)", data.Columns.ValueString())
+	}
+	statement += " " + connectorClauses(ctx, data.ConnectorModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statements := []string{statement}
+	results, err := r.client.ExecuteSQLBatch(ctx, nsID, database, statements)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create source", err.Error())
+		return
+	}
+	if addBatchSQLDiagnostics(statements, results, "Unable to create source", &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := r.client.GetSource(ctx, nsID, database, schema, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read source", err.Error())
+		return
+	}
+
+	sourceToDataModel(nsID, database, source, &data)
+
+	tflog.Info(ctx, fmt.Sprintf("source created, name: %s.%s", schema, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to read the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "source", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := r.client.GetSource(ctx, nsID, database, schema, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrSourceNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("source %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read source", err.Error())
+		return
+	}
+
+	sourceToDataModel(nsID, database, source, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with a change, every configurable attribute requires a replacement.
+func (r *SourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete refuses to drop a source that others read from. It is checked here rather than while
+// planning, since terraform destroys the objects depending on it first.
+func (r *SourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.ID.IsNull() {
+		resp.Diagnostics.AddError("ID is missing", "ID is required to delete the resource")
+		return
+	}
+
+	nsID, database, schema, name := parseSchemaObjectIdentifier(data.ID.ValueString(), "source", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// a source created in another schema before the schema was restricted: dropping it by name
+	// could drop the source of the same name in the default schema instead.
+	if schema != defaultSchema {
+		resp.Diagnostics.AddError(
+			"Unable to delete source",
+			unsupportedSchemaMessage("Sources", schema)+fmt.Sprintf(
+				". Drop the source %s.%s in SQL and remove it from the state with `terraform state rm`.", schema, name),
+		)
+		return
+	}
+
+	downstreams, err := r.client.GetSourceDownstreams(ctx, nsID, database, name)
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrSourceNotFound) || errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("source %s not found, it is already gone", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read source dependencies", err.Error())
+		return
+	}
+	if len(downstreams) > 0 {
+		resp.Diagnostics.AddError(
+			"Source is in use",
+			fmt.Sprintf(
+				"The source %s.%s in database %s is read by the objects below. Drop them, or remove them from the "+
+					"configuration, before deleting or replacing the source.\n\n%s",
+				schema, name, database, formatDependencies(downstreams),
+			),
+		)
+		return
+	}
+
+	if err := r.client.DeleteSource(ctx, nsID, database, name); err != nil {
+		resp.Diagnostics.AddError("Unable to delete source", err.Error())
+		return
+	}
+}
+
+func (r *SourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nsID, database, schema, name := parseSchemaObjectIdentifier(req.ID, "source", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if schema != defaultSchema {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to import source with ID: %s", req.ID),
+			unsupportedSchemaMessage("Sources", schema),
+		)
+		return
+	}
+
+	if _, err := r.client.GetSource(ctx, nsID, database, schema, name); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Unable to import source with ID: %s", req.ID), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
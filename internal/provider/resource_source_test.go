@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sourceTerraformValue(objectType tftypes.Type, nsID uuid.UUID, id, owner tftypes.Value) tftypes.Value {
+	stringMap := tftypes.Map{ElementType: tftypes.String}
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":         id,
+		"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+		"database":   tftypes.NewValue(tftypes.String, "dev"),
+		"schema":     tftypes.NewValue(tftypes.String, "public"),
+		"name":       tftypes.NewValue(tftypes.String, "orders"),
+		"columns":    tftypes.NewValue(tftypes.String, "id BIGINT, amount DECIMAL"),
+		"owner":      owner,
+		"connector":  tftypes.NewValue(tftypes.String, "kafka"),
+		"properties": tftypes.NewValue(stringMap, map[string]tftypes.Value{
+			"topic": tftypes.NewValue(tftypes.String, "orders"),
+		}),
+		"sensitive_properties": tftypes.NewValue(stringMap, nil),
+		"secret_properties": tftypes.NewValue(stringMap, map[string]tftypes.Value{
+			"properties.sasl.password": tftypes.NewValue(tftypes.String, "kafka_password"),
+		}),
+		"format":            tftypes.NewValue(tftypes.String, "PLAIN"),
+		"encode":            tftypes.NewValue(tftypes.String, "JSON"),
+		"encode_properties": tftypes.NewValue(stringMap, nil),
+	})
+}
+
+func TestSourceCreate(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	ctrl := gomock.NewController(t)
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+
+	statements := []string{
+		`CREATE SOURCE "public"."orders" (id BIGINT, amount DECIMAL) WITH (connector = 'kafka', topic = 'orders', ` +
+			`properties.sasl.password = SECRET "kafka_password") FORMAT PLAIN ENCODE JSON`,
+	}
+	connectorType := "kafka"
+	gomock.InOrder(
+		client.EXPECT().ExecuteSQLBatch(gomock.Any(), nsID, "dev", statements).Return([]apigen_mgmtv2.BatchQueryResult{
+			{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+		}, nil),
+		client.EXPECT().GetSource(gomock.Any(), nsID, "dev", "public", "orders").Return(&apigen_mgmtv2.SourceInfo{
+			Schema:        "public",
+			SourceName:    "orders",
+			Owner:         "root",
+			ConnectorType: &connectorType,
+			Properties:    map[string]string{"connector": "kafka", "topic": "orders", "scan.startup.mode": "earliest"},
+		}, nil),
+	)
+
+	r := &SourceResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	plan := sourceTerraformValue(objectType, nsID, tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+	resp := &resource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var data SourceModel
+	require.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, nsID.String()+".dev.public.orders", data.ID.ValueString())
+	assert.Equal(t, "root", data.Owner.ValueString())
+	// the default the platform reports is not taken into the configured properties.
+	assert.Equal(t, stringMap(map[string]string{"topic": "orders"}), data.Properties)
+}
+
+func TestSourceDelete(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		expect      func(client *cloudsdk_mock.MockCloudClientInterface)
+		expectError bool
+	}{
+		{
+			name: "unused source",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSourceDownstreams(gomock.Any(), nsID, "dev", "orders").Return(nil, nil)
+				client.EXPECT().DeleteSource(gomock.Any(), nsID, "dev", "orders").Return(nil)
+			},
+		},
+		{
+			name: "source in use",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSourceDownstreams(gomock.Any(), nsID, "dev", "orders").Return([]apigen_mgmtv2.RwDependency{
+					{Name: "revenue", Schema: "public", Type: "MATERIALIZED VIEW"},
+				}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "source already gone",
+			expect: func(client *cloudsdk_mock.MockCloudClientInterface) {
+				client.EXPECT().GetSourceDownstreams(gomock.Any(), nsID, "dev", "orders").
+					Return(nil, errors.Wrap(cloudsdk.ErrSourceNotFound, "orders"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			tt.expect(client)
+
+			r := &SourceResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+			state := sourceTerraformValue(objectType, nsID, tftypes.NewValue(tftypes.String, nsID.String()+".dev.public.orders"), tftypes.NewValue(tftypes.String, "root"))
+			resp := &resource.DeleteResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			r.Delete(ctx, resource.DeleteRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}, resp)
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			if tt.expectError {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "materialized view public.revenue")
+			}
+		})
+	}
+}
+
+// The downstreams and the drop address a source by name alone, so a source outside the default
+// schema is rejected rather than risking an action on `public.orders`.
+func TestSourceOnlyInDefaultSchema(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	ctrl := gomock.NewController(t)
+	// no call is expected: any would act on the source of the same name in the default schema.
+	client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+	r := &SourceResource{client: client}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	schemaAttribute, ok := schemaResp.Schema.Attributes["schema"].(schema.StringAttribute)
+	require.True(t, ok)
+	for value, expectErr := range map[string]bool{defaultSchema: false, "staging": true} {
+		validateResp := &validator.StringResponse{}
+		for _, v := range schemaAttribute.Validators {
+			v.ValidateString(ctx, validator.StringRequest{Path: path.Root("schema"), ConfigValue: types.StringValue(value)}, validateResp)
+		}
+		assert.Equal(t, expectErr, validateResp.Diagnostics.HasError(), "value: %s", value)
+	}
+
+	importResp := &resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: nsID.String() + ".dev.staging.orders"}, importResp)
+	assert.True(t, importResp.Diagnostics.HasError())
+
+	state := sourceTerraformValue(objectType, nsID, tftypes.NewValue(tftypes.String, nsID.String()+".dev.staging.orders"), tftypes.NewValue(tftypes.String, "root"))
+	deleteResp := &resource.DeleteResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state}}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state}}, deleteResp)
+	require.True(t, deleteResp.Diagnostics.HasError())
+	assert.Contains(t, deleteResp.Diagnostics.Errors()[0].Detail(), "terraform state rm")
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+)
+
+// Assert provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SQLObjectResource{}
+var _ resource.ResourceWithValidateConfig = &SQLObjectResource{}
+
+func NewSQLObjectResource() resource.Resource {
+	return &SQLObjectResource{}
+}
+
+// SQLObjectResource manages any object that can be created and dropped in SQL, e.g. a table or a
+// sink. The provider does not interpret the statements, it only runs them.
+type SQLObjectResource struct {
+	client cloudsdk.CloudClientInterface
+}
+
+type SQLObjectModel struct {
+	ID         types.String `tfsdk:"id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	Database   types.String `tfsdk:"database"`
+	CreateSQL  types.List   `tfsdk:"create_sql"`
+	DestroySQL types.List   `tfsdk:"destroy_sql"`
+	ReadSQL    types.String `tfsdk:"read_sql"`
+}
+
+func (r *SQLObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_object"
+}
+
+func (r *SQLObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "An object in a database of a RisingWave cluster managed with the SQL statements creating and dropping it.",
+		MarkdownDescription: sqlObjectMarkdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A random identifier for the resource, the object itself is only known to the statements.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The NsID (namespace id) of the cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: "The name of the database the statements run in, e.g. the `name` of a " +
+					"`risingwavecloud_database` resource.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"create_sql": schema.ListAttribute{
+				MarkdownDescription: "The statements creating the object, one statement per element, run in order. " +
+					"Changing them replaces the object: `destroy_sql` runs first, then the new statements.",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"destroy_sql": schema.ListAttribute{
+				MarkdownDescription: "The statements dropping the object, one statement per element, run in order. " +
+					"Changing them does not touch the object, they are only run when it is destroyed or replaced.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"read_sql": schema.StringAttribute{
+				MarkdownDescription: "A query returning at least one row while the object exists, e.g. " +
+					"`SELECT 1 FROM rw_catalog.rw_tables WHERE name = 'orders'`. If the query returns no rows on " +
+					"refresh, the object is considered deleted outside of Terraform and is planned to be created again. " +
+					"Without it, changes made outside of Terraform are not detected.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *SQLObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(cloudsdk.CloudClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected cloudsdk.CloudClientInterface, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SQLObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SQLObjectModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the statements may come from a variable and be unknown for now.
+	if !data.CreateSQL.IsUnknown() && len(data.CreateSQL.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("create_sql"), "Missing statements", "At least one statement is required to create the object.")
+	}
+	if !data.DestroySQL.IsUnknown() && len(data.DestroySQL.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("destroy_sql"), "Missing statements", "At least one statement is required to drop the object.")
+	}
+}
+
+// addBatchSQLDiagnostics reports every statement of the batch that failed as an error. It returns
+// the number of statements that succeeded before the first failure, the statements are not run in
+// a transaction so these are not rolled back.
+func addBatchSQLDiagnostics(statements []string, results []apigen_mgmtv2.BatchQueryResult, summary string, diags *diag.Diagnostics) int {
+	var (
+		applied = 0
+		failed  = false
+	)
+	for i, result := range results {
+		if result.Error != nil {
+			failed = true
+			diags.AddError(summary, fmt.Sprintf("Statement %d of %d failed: %s\n\n%s", i+1, len(statements), *result.Error, result.Query))
+			continue
+		}
+		if !failed {
+			applied++
+		}
+	}
+	// the platform may stop at the first failure without reporting the statements it skipped.
+	if !failed && len(results) < len(statements) {
+		diags.AddError(summary, fmt.Sprintf("Only %d of %d statements were run", len(results), len(statements)))
+	}
+	return applied
+}
+
+func (r *SQLObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SQLObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	var statements []string
+	resp.Diagnostics.Append(data.CreateSQL.ElementsAs(ctx, &statements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(uuid.NewString())
+
+	results, err := r.client.ExecuteSQLBatch(ctx, nsID, data.Database.ValueString(), statements)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create SQL object", err.Error())
+		return
+	}
+	if applied := addBatchSQLDiagnostics(statements, results, "Unable to create SQL object", &resp.Diagnostics); resp.Diagnostics.HasError() {
+		// the statements that succeeded left a partial object behind. Saving the state along
+		// with the error taints the resource, so the next apply runs destroy_sql before trying
+		// again.
+		if applied > 0 {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("SQL object created, id: %s", data.ID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SQLObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SQLObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// without a query there is nothing to compare with, the state is kept as is.
+	if data.ReadSQL.IsNull() || data.ReadSQL.IsUnknown() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	result, err := r.client.QuerySQL(ctx, nsID, data.Database.ValueString(), data.ReadSQL.ValueString())
+	if err != nil {
+		if errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("database of SQL object %s not found, removing it from the state", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("read_sql"), "Unable to read SQL object", err.Error())
+		return
+	}
+	if len(result.Rows) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("read_sql of SQL object %s returned no rows, removing it from the state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores destroy_sql and read_sql, a change to the object itself requires a
+// replacement.
+func (r *SQLObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SQLObjectModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SQLObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SQLObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsID, err := uuid.Parse(data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("cluster_id is invalid", fmt.Sprintf("Cannot parse cluster ID %s", data.ClusterID.String()))
+		return
+	}
+
+	var statements []string
+	resp.Diagnostics.Append(data.DestroySQL.ElementsAs(ctx, &statements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := r.client.ExecuteSQLBatch(ctx, nsID, data.Database.ValueString(), statements)
+	if err != nil {
+		// the object goes away with its database or cluster.
+		if errors.Is(err, cloudsdk.ErrDatabaseNotFound) || errors.Is(err, cloudsdk.ErrClusterNotFound) {
+			tflog.Info(ctx, fmt.Sprintf("database of SQL object %s not found, it is already gone", data.ID.ValueString()))
+			return
+		}
+		resp.Diagnostics.AddError("Unable to drop SQL object", err.Error())
+		return
+	}
+	addBatchSQLDiagnostics(statements, results, "Unable to drop SQL object", &resp.Diagnostics)
+}
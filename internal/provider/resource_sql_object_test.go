@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apigen_mgmtv2 "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/apigen/mgmt/v2"
+	cloudsdk_mock "github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/cloudsdk/mock"
+	"github.com/risingwavelabs/terraform-provider-risingwavecloud/internal/utils/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBatchSQLDiagnostics(t *testing.T) {
+	statements := []string{"CREATE TABLE a (v INT)", "CREATE TABLE b (v INT)", "CREATE TABLE c (v INT)"}
+
+	var diags diag.Diagnostics
+	applied := addBatchSQLDiagnostics(statements, []apigen_mgmtv2.BatchQueryResult{
+		{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+		{Query: statements[1], Error: ptr.Ptr(`table "b" already exists`)},
+		{Query: statements[2], Result: &apigen_mgmtv2.QueryResult{}},
+	}, "Unable to create SQL object", &diags)
+	assert.Equal(t, 1, applied)
+	require.Equal(t, 1, diags.ErrorsCount())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Statement 2 of 3 failed")
+	assert.Contains(t, diags.Errors()[0].Detail(), `table "b" already exists`)
+
+	diags = nil
+	applied = addBatchSQLDiagnostics(statements, []apigen_mgmtv2.BatchQueryResult{
+		{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+	}, "Unable to create SQL object", &diags)
+	assert.Equal(t, 1, applied)
+	assert.True(t, diags.HasError(), "skipped statements must be reported")
+
+	diags = nil
+	applied = addBatchSQLDiagnostics(statements[:1], []apigen_mgmtv2.BatchQueryResult{
+		{Query: statements[0], Result: &apigen_mgmtv2.QueryResult{}},
+	}, "Unable to create SQL object", &diags)
+	assert.Equal(t, 1, applied)
+	assert.False(t, diags.HasError())
+}
+
+func TestSQLObjectCreatePartialFailure(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	tests := []struct {
+		name        string
+		results     []apigen_mgmtv2.BatchQueryResult
+		expectState bool
+	}{
+		{
+			name: "first statement failed",
+			results: []apigen_mgmtv2.BatchQueryResult{
+				{Query: "CREATE SOURCE s", Error: ptr.Ptr("broker unreachable")},
+			},
+		},
+		{
+			name: "second statement failed",
+			results: []apigen_mgmtv2.BatchQueryResult{
+				{Query: "CREATE SOURCE s", Result: &apigen_mgmtv2.QueryResult{}},
+				{Query: "CREATE MATERIALIZED VIEW mv", Error: ptr.Ptr("column not found")},
+			},
+			expectState: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.EXPECT().
+				ExecuteSQLBatch(gomock.Any(), nsID, "dev", []string{"CREATE SOURCE s", "CREATE MATERIALIZED VIEW mv"}).
+				Return(tt.results, nil)
+
+			r := &SQLObjectResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			listType := tftypes.List{ElementType: tftypes.String}
+
+			plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+				"database":   tftypes.NewValue(tftypes.String, "dev"),
+				"create_sql": tftypes.NewValue(listType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "CREATE SOURCE s"),
+					tftypes.NewValue(tftypes.String, "CREATE MATERIALIZED VIEW mv"),
+				}),
+				"destroy_sql": tftypes.NewValue(listType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "DROP SOURCE IF EXISTS s CASCADE"),
+				}),
+				"read_sql": tftypes.NewValue(tftypes.String, nil),
+			})
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			r.Create(ctx, resource.CreateRequest{
+				Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectState, !resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestSQLObjectReadDrift(t *testing.T) {
+	ctx := context.Background()
+	nsID := uuid.Must(uuid.NewRandom())
+
+	for _, tt := range []struct {
+		name         string
+		rows         []apigen_mgmtv2.QueryRow
+		expectExists bool
+	}{
+		{name: "object exists", rows: []apigen_mgmtv2.QueryRow{{1}}, expectExists: true},
+		{name: "object dropped", rows: nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := cloudsdk_mock.NewMockCloudClientInterface(ctrl)
+			client.EXPECT().
+				QuerySQL(gomock.Any(), nsID, "dev", "SELECT 1 FROM rw_catalog.rw_tables WHERE name = 'orders'").
+				Return(&apigen_mgmtv2.QueryResult{Rows: tt.rows}, nil)
+
+			r := &SQLObjectResource{client: client}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+			objectType := schemaResp.Schema.Type().TerraformType(ctx)
+			listType := tftypes.List{ElementType: tftypes.String}
+
+			state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":         tftypes.NewValue(tftypes.String, uuid.NewString()),
+				"cluster_id": tftypes.NewValue(tftypes.String, nsID.String()),
+				"database":   tftypes.NewValue(tftypes.String, "dev"),
+				"create_sql": tftypes.NewValue(listType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "CREATE TABLE orders (id BIGINT PRIMARY KEY)"),
+				}),
+				"destroy_sql": tftypes.NewValue(listType, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "DROP TABLE IF EXISTS orders"),
+				}),
+				"read_sql": tftypes.NewValue(tftypes.String, "SELECT 1 FROM rw_catalog.rw_tables WHERE name = 'orders'"),
+			})
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}
+			r.Read(ctx, resource.ReadRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+			assert.Equal(t, tt.expectExists, !resp.State.Raw.IsNull())
+		})
+	}
+}